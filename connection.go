@@ -233,6 +233,9 @@ func (c *connection) Table() table.Client {
 				)...,
 			),
 		)
+		c.balancer.OnUpdate(func(ctx context.Context, endpoints []endpoint.Info) {
+			c.table.UpdateNodes(endpoints)
+		})
 		return c.table.Close
 	})
 	// may be nil if driver closed early
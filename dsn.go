@@ -0,0 +1,216 @@
+package ydb
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/balancers"
+	"github.com/ydb-platform/ydb-go-sdk/v3/config"
+	balancerConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql"
+	"github.com/ydb-platform/ydb-go-sdk/v3/log"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+var errUnknownParam = xerrors.Wrap(fmt.Errorf("unknown param of connection string"))
+
+// dsnParsers maps params of connection string to connection options.
+// Params which depends on each other (such as user and password) parsed by dsnCompositeParsers
+var dsnParsers = map[string]func(value string) (Option, error){
+	"token": func(value string) (Option, error) {
+		return WithAccessTokenCredentials(value), nil
+	},
+	"ca_file": func(value string) (Option, error) {
+		return WithCertificatesFromFile(value), nil
+	},
+	"dial_timeout": func(value string) (Option, error) {
+		timeout, err := parseDuration(value)
+		if err != nil {
+			return nil, err
+		}
+		return WithDialTimeout(timeout), nil
+	},
+	"operation_timeout": func(value string) (Option, error) {
+		timeout, err := parseDuration(value)
+		if err != nil {
+			return nil, err
+		}
+		return With(config.WithOperationTimeout(timeout)), nil
+	},
+	"session_pool_size_limit": func(value string) (Option, error) {
+		sizeLimit, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, err
+		}
+		return WithSessionPoolSizeLimit(sizeLimit), nil
+	},
+	"session_pool_min_idle_size": func(value string) (Option, error) {
+		minIdleSize, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, err
+		}
+		return WithSessionPoolMinIdleSize(minIdleSize), nil
+	},
+	"session_pool_idle_threshold": func(value string) (Option, error) {
+		idleThreshold, err := parseDuration(value)
+		if err != nil {
+			return nil, err
+		}
+		return WithSessionPoolIdleThreshold(idleThreshold), nil
+	},
+	"session_pool_create_session_timeout": func(value string) (Option, error) {
+		timeout, err := parseDuration(value)
+		if err != nil {
+			return nil, err
+		}
+		return WithSessionPoolCreateSessionTimeout(timeout), nil
+	},
+}
+
+// dsnCompositeParsers parses groups of params of connection string into connection options
+var dsnCompositeParsers = []struct {
+	params []string
+	parse  func(values map[string]string) (Option, error)
+}{
+	{
+		params: []string{"user", "password"},
+		parse: func(values map[string]string) (Option, error) {
+			user, password := values["user"], values["password"]
+			if user == "" {
+				return nil, fmt.Errorf("empty user with password")
+			}
+			return WithStaticCredentials(user, password), nil
+		},
+	},
+	{
+		params: []string{"balancer", "preferred_locations", "balancer_fallback"},
+		parse:  parseBalancer,
+	},
+	{
+		params: []string{"log_level", "log_details"},
+		parse: func(values map[string]string) (Option, error) {
+			l, has := values["log_level"]
+			if !has {
+				l = "info"
+			}
+			minLevel := log.FromString(l)
+			if minLevel == log.QUIET {
+				if !strings.EqualFold(l, "quiet") {
+					return nil, fmt.Errorf("unknown log level: %s", l)
+				}
+				return func(ctx context.Context, c *connection) error { return nil }, nil
+			}
+			details := trace.DetailsAll
+			if pattern, has := values["log_details"]; has {
+				details = trace.MatchDetails(pattern)
+			}
+			return WithLogger(details,
+				WithNamespace("ydb"),
+				WithMinLevel(minLevel),
+			), nil
+		},
+	},
+}
+
+func parseBalancer(values map[string]string) (Option, error) {
+	var (
+		b        *balancerConfig.Config
+		fallback bool
+		err      error
+	)
+	switch name := values["balancer"]; {
+	case strings.HasPrefix(name, "{"):
+		b, err = balancers.CreateFromConfig(name)
+		if err != nil {
+			return nil, err
+		}
+	case name == "", name == "random_choice":
+		b = balancers.RandomChoice()
+	case name == "round_robin":
+		b = balancers.RoundRobin()
+	case name == "single":
+		b = balancers.SingleConn()
+	default:
+		return nil, fmt.Errorf("unknown balancer: %s", name)
+	}
+	if v, has := values["balancer_fallback"]; has {
+		if fallback, err = strconv.ParseBool(v); err != nil {
+			return nil, err
+		}
+	}
+	switch locations := values["preferred_locations"]; {
+	case locations == "local_dc" && fallback:
+		b = balancers.PreferLocalDCWithFallBack(b)
+	case locations == "local_dc":
+		b = balancers.PreferLocalDC(b)
+	case locations != "" && fallback:
+		b = balancers.PreferLocationsWithFallback(b, strings.Split(locations, ",")...)
+	case locations != "":
+		b = balancers.PreferLocations(b, strings.Split(locations, ",")...)
+	case fallback:
+		return nil, fmt.Errorf("balancer fallback without preferred locations")
+	}
+	return WithBalancer(b), nil
+}
+
+// parseDuration parses duration in time.ParseDuration format or integer count of seconds
+func parseDuration(value string) (time.Duration, error) {
+	if seconds, err := strconv.ParseUint(value, 10, 64); err == nil {
+		return time.Duration(seconds) * time.Second, nil
+	}
+	return time.ParseDuration(value)
+}
+
+// dsnOptions returns connection options from params of connection string.
+// Params of database/sql connector are skipped, any other unknown param is an error
+func dsnOptions(params url.Values) (opts []Option, _ error) {
+	var names []string
+	for param := range params {
+		names = append(names, param)
+	}
+	sort.Strings(names)
+	known := make(map[string]bool, len(names))
+	for _, param := range names {
+		p, has := dsnParsers[param]
+		if !has {
+			continue
+		}
+		known[param] = true
+		opt, err := p(strings.TrimSpace(params.Get(param)))
+		if err != nil {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("wrong param '%s': %w", param, err))
+		}
+		opts = append(opts, opt)
+	}
+	for _, p := range dsnCompositeParsers {
+		values := make(map[string]string, len(p.params))
+		for _, param := range p.params {
+			if _, has := params[param]; has {
+				known[param] = true
+				values[param] = strings.TrimSpace(params.Get(param))
+			}
+		}
+		if len(values) == 0 {
+			continue
+		}
+		opt, err := p.parse(values)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(
+				fmt.Errorf("wrong params '%s': %w", strings.Join(p.params, "', '"), err),
+			)
+		}
+		opts = append(opts, opt)
+	}
+	for _, param := range names {
+		if !known[param] && !xsql.IsParam(param) {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("%w: '%s'", errUnknownParam, param))
+		}
+	}
+	return opts, nil
+}
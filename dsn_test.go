@@ -0,0 +1,65 @@
+package ydb
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/config"
+	tableConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/table/config"
+)
+
+func TestDSNOptions(t *testing.T) {
+	params, err := url.ParseQuery("" +
+		"dial_timeout=5s&" +
+		"operation_timeout=10&" +
+		"session_pool_size_limit=100&" +
+		"session_pool_min_idle_size=10&" +
+		"user=root&password=secret&" +
+		"balancer=round_robin&preferred_locations=a,b&balancer_fallback=true&" +
+		"query_mode=scan",
+	)
+	require.NoError(t, err)
+	opts, err := dsnOptions(params)
+	require.NoError(t, err)
+	c := &connection{}
+	for _, opt := range opts {
+		require.NoError(t, opt(context.Background(), c))
+	}
+	cfg := config.New(c.options...)
+	require.Equal(t, 5*time.Second, cfg.DialTimeout())
+	require.Equal(t, 10*time.Second, cfg.OperationTimeout())
+	require.NotNil(t, cfg.Balancer())
+	require.True(t, cfg.Balancer().AllowFalback)
+	require.NotNil(t, c.userInfo)
+	require.Equal(t, "root", c.userInfo.User)
+	require.Equal(t, "secret", c.userInfo.Password)
+	tableCfg := tableConfig.New(c.tableOptions...)
+	require.Equal(t, 100, tableCfg.SizeLimit())
+	require.Equal(t, 10, tableCfg.MinIdleSize())
+}
+
+func TestDSNOptionsErrors(t *testing.T) {
+	for _, query := range []string{
+		"unknown=1",
+		"dial_timeout=abc",
+		"session_pool_size_limit=many",
+		"password=secret",
+		"balancer=unknown",
+		"balancer_fallback=true",
+		"log_level=loud",
+	} {
+		t.Run(query, func(t *testing.T) {
+			params, err := url.ParseQuery(query)
+			require.NoError(t, err)
+			_, err = dsnOptions(params)
+			require.Error(t, err)
+		})
+	}
+	_, err := dsnOptions(url.Values{"unknown": {"1"}})
+	require.True(t, errors.Is(err, errUnknownParam))
+}
@@ -7,7 +7,7 @@ require (
 	github.com/golang/mock v1.6.0
 	github.com/jonboulle/clockwork v0.2.2
 	github.com/stretchr/testify v1.7.1
-	github.com/ydb-platform/ydb-go-genproto v0.0.0-20220801095836-cf975531fd1f
+	github.com/ydb-platform/ydb-go-genproto v0.0.0-20240126124512-dbb0e1720dbf
 	google.golang.org/grpc v1.47.0
 	google.golang.org/protobuf v1.28.0
 )
@@ -31,6 +31,8 @@ type Balancer struct {
 
 	mu               xsync.RWMutex
 	connectionsState *connectionsState
+	endpoints        []endpoint.Info
+	onUpdate         []func(ctx context.Context, endpoints []endpoint.Info)
 }
 
 func (b *Balancer) clusterDiscovery(ctx context.Context) (err error) {
@@ -83,9 +85,34 @@ func (b *Balancer) applyDiscoveredEndpoints(ctx context.Context, endpoints []end
 	info := balancerConfig.Info{SelfLocation: localDC}
 	state := newConnectionsState(connections, b.balancerConfig.IsPreferConn, info, b.balancerConfig.AllowFalback)
 
+	infos := make([]endpoint.Info, 0, len(endpoints))
+	for _, e := range endpoints {
+		infos = append(infos, e.Copy())
+	}
+
+	var onUpdate []func(ctx context.Context, endpoints []endpoint.Info)
 	b.mu.WithLock(func() {
 		b.connectionsState = state
+		b.endpoints = infos
+		onUpdate = append(onUpdate, b.onUpdate...)
+	})
+
+	for _, f := range onUpdate {
+		f(ctx, infos)
+	}
+}
+
+// OnUpdate registers callback which calls with discovered endpoints on each update of balancer.
+// If balancer already has discovered endpoints then callback calls immediately
+func (b *Balancer) OnUpdate(onUpdate func(ctx context.Context, endpoints []endpoint.Info)) {
+	var endpoints []endpoint.Info
+	b.mu.WithLock(func() {
+		b.onUpdate = append(b.onUpdate, onUpdate)
+		endpoints = b.endpoints
 	})
+	if len(endpoints) > 0 {
+		onUpdate(context.Background(), endpoints)
+	}
 }
 
 func (b *Balancer) Discovery() discovery.Client {
@@ -7,6 +7,9 @@ import (
 
 const wordSize = bits.UintSize / 8
 
+// MaxPrecision is a maximum precision of YDB Decimal type
+const MaxPrecision = 35
+
 var (
 	ten  = big.NewInt(10)
 	zero = big.NewInt(0)
@@ -64,7 +67,7 @@ func FromBytes(bts []byte, precision, scale uint32) *big.Int {
 		v.Add(v, one)
 		v.Neg(v)
 	}
-	if v.CmpAbs(pow(ten, precision)) >= 0 {
+	if v.CmpAbs(pow(ten, precision)) >= 0 && !IsNaN(v) {
 		if neg {
 			v.Set(neginf)
 		} else {
@@ -244,7 +247,7 @@ func Format(x *big.Int, precision, scale uint32) string {
 		pos--
 		bts[pos] = '.'
 	}
-	if bts[pos] == '.' {
+	if pos == len(bts) || bts[pos] == '.' {
 		pos--
 		bts[pos] = '0'
 	}
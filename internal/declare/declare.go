@@ -0,0 +1,113 @@
+package declare
+
+import (
+	"bytes"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+)
+
+var declareRe = regexp.MustCompile(`(?i)\bDECLARE\s+(\$[A-Za-z_][A-Za-z0-9_]*)\s+AS\b`)
+
+// Declared returns set of parameter names which query declares itself.
+// DECLARE statements in comments and string literals are not taken into account
+func Declared(query string) map[string]struct{} {
+	matches := declareRe.FindAllStringSubmatch(code(query), -1)
+	declared := make(map[string]struct{}, len(matches))
+	for _, m := range matches {
+		declared[m[1]] = struct{}{}
+	}
+	return declared
+}
+
+// Section generates DECLARE section for parameters which not declared in query.
+// Declarations are sorted by parameter name
+func Section(query string, params map[string]*Ydb.TypedValue) string {
+	if len(params) == 0 {
+		return ""
+	}
+	var (
+		declared = Declared(query)
+		names    = make([]string, 0, len(params))
+		buf      bytes.Buffer
+	)
+	for name := range params {
+		if _, has := declared[name]; !has {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		buf.WriteString("DECLARE ")
+		buf.WriteString(name)
+		buf.WriteString(" AS ")
+		value.WriteTypeStringTo(&buf, value.TypeFromYDB(params[name].GetType()))
+		buf.WriteString(";\n")
+	}
+	return buf.String()
+}
+
+// Prepend prepends query with DECLARE section for parameters which not declared in query
+func Prepend(query string, params map[string]*Ydb.TypedValue) string {
+	return Section(query, params) + query
+}
+
+// code replaces comments, string literals and quoted identifiers of query with spaces,
+// so only YQL code of query remains matchable
+func code(query string) string {
+	var (
+		buf strings.Builder
+		i   int
+	)
+	buf.Grow(len(query))
+	for i < len(query) {
+		end := skip(query, i)
+		if end == i {
+			buf.WriteByte(query[i])
+			i++
+			continue
+		}
+		buf.WriteString(strings.Repeat(" ", end-i))
+		i = end
+	}
+	return buf.String()
+}
+
+// skip returns end of comment, string literal or quoted identifier which starts at position i
+// of query. skip returns i if no one of them starts at i
+func skip(query string, i int) int {
+	rest := query[i:]
+	switch {
+	case strings.HasPrefix(rest, "--"):
+		if n := strings.IndexByte(rest, '\n'); n >= 0 {
+			return i + n
+		}
+		return len(query)
+	case strings.HasPrefix(rest, "/*"):
+		if n := strings.Index(rest[2:], "*/"); n >= 0 {
+			return i + 2 + n + 2
+		}
+		return len(query)
+	case strings.HasPrefix(rest, "@@"):
+		if n := strings.Index(rest[2:], "@@"); n >= 0 {
+			return i + 2 + n + 2
+		}
+		return len(query)
+	case rest[0] == '\'' || rest[0] == '"' || rest[0] == '`':
+		for j := 1; j < len(rest); j++ {
+			switch rest[j] {
+			case '\\':
+				j++
+			case rest[0]:
+				return i + j + 1
+			}
+		}
+		return len(query)
+	default:
+		return i
+	}
+}
@@ -0,0 +1,55 @@
+package declare
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+func TestDeclared(t *testing.T) {
+	require.Equal(t,
+		map[string]struct{}{
+			"$a":   {},
+			"$b_1": {},
+		},
+		Declared("DECLARE $a AS Int32;\ndeclare   $b_1 as List<Int32>; SELECT $a, $c;"),
+	)
+	require.Equal(t,
+		map[string]struct{}{
+			"$a": {},
+		},
+		Declared(strings.Join([]string{
+			"-- DECLARE $b AS Int32;",
+			"/* DECLARE $c AS Int32;",
+			"   DECLARE $d AS Int32; */",
+			"DECLARE $a AS Int32;",
+			"SELECT 'DECLARE $e AS Int32;', \"it\\\"s DECLARE $f AS Int32\",",
+			"  @@DECLARE $g AS Int32;@@, `DECLARE $h AS Int32`, $a;",
+		}, "\n")),
+	)
+}
+
+func TestSection(t *testing.T) {
+	a := allocator.New()
+	defer a.Free()
+	params := map[string]*Ydb.TypedValue{
+		"$c": value.ToYDB(types.ListValue(types.Int32Value(1)), a),
+		"$a": value.ToYDB(types.Int32Value(1), a),
+		"$b": value.ToYDB(types.UTF8Value("b"), a),
+	}
+	require.Equal(t, "", Section("", nil))
+	require.Equal(t,
+		"DECLARE $a AS Int32;\nDECLARE $c AS List<Int32>;\n",
+		Section("DECLARE $b AS Utf8; SELECT $a, $b, $c;", params),
+	)
+	require.Equal(t,
+		"DECLARE $a AS Int32;\nDECLARE $b AS Utf8;\nDECLARE $c AS List<Int32>;\nSELECT 1;",
+		Prepend("SELECT 1;", params),
+	)
+}
@@ -43,6 +43,9 @@ type parsedInfo struct {
 	Secure   bool
 	UserInfo *UserInfo
 	Options  []config.Option
+
+	// Params contains query params of connection string which have no registered parser
+	Params url.Values
 }
 
 func Parse(dsn string) (info parsedInfo, err error) {
@@ -60,15 +63,19 @@ func Parse(dsn string) (info parsedInfo, err error) {
 			Password: password,
 		}
 	}
+	info.Params = url.Values{}
 	for param, values := range uri.Query() {
-		if p, has := parsers[param]; has {
-			for _, v := range values {
-				var parsed []config.Option
-				if parsed, err = p(v); err != nil {
-					return info, xerrors.WithStackTrace(err)
-				}
-				info.Options = append(info.Options, parsed...)
+		p, has := parsers[param]
+		if !has {
+			info.Params[param] = values
+			continue
+		}
+		for _, v := range values {
+			var parsed []config.Option
+			if parsed, err = p(v); err != nil {
+				return info, xerrors.WithStackTrace(err)
 			}
+			info.Options = append(info.Options, parsed...)
 		}
 	}
 	return info, nil
@@ -1,7 +1,6 @@
 package rawtopiccommon
 
 import (
-	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_PersQueue_V1"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Topic"
 )
 
@@ -10,10 +9,10 @@ type Codec int
 
 const (
 	CodecUNSPECIFIED Codec = iota
-	CodecRaw               = Codec(Ydb_PersQueue_V1.Codec_CODEC_RAW)
-	CodecGzip              = Codec(Ydb_PersQueue_V1.Codec_CODEC_GZIP)
-	CodecLzop              = Codec(Ydb_PersQueue_V1.Codec_CODEC_LZOP)
-	CodecZstd              = Codec(Ydb_PersQueue_V1.Codec_CODEC_ZSTD)
+	CodecRaw               = Codec(Ydb_Topic.Codec_CODEC_RAW)
+	CodecGzip              = Codec(Ydb_Topic.Codec_CODEC_GZIP)
+	CodecLzop              = Codec(Ydb_Topic.Codec_CODEC_LZOP)
+	CodecZstd              = Codec(Ydb_Topic.Codec_CODEC_ZSTD)
 )
 
 const (
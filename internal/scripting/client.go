@@ -7,6 +7,7 @@ import (
 	"github.com/ydb-platform/ydb-go-genproto/Ydb_Scripting_V1"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Scripting"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_TableStats"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/proto"
@@ -20,6 +21,7 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
 	"github.com/ydb-platform/ydb-go-sdk/v3/scripting"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/result"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
@@ -40,12 +42,21 @@ func (c *Client) Execute(
 	ctx context.Context,
 	query string,
 	params *table.QueryParameters,
+) (r result.Result, err error) {
+	return c.ExecuteWithOptions(ctx, query, params)
+}
+
+func (c *Client) ExecuteWithOptions(
+	ctx context.Context,
+	query string,
+	params *table.QueryParameters,
+	opts ...options.ExecuteDataQueryOption,
 ) (r result.Result, err error) {
 	if c == nil {
 		return r, xerrors.WithStackTrace(errNilClient)
 	}
 	call := func(ctx context.Context) error {
-		r, err = c.execute(ctx, query, params)
+		r, err = c.execute(ctx, query, params, opts...)
 		return xerrors.WithStackTrace(err)
 	}
 	if !c.config.AutoRetry() {
@@ -60,6 +71,7 @@ func (c *Client) execute(
 	ctx context.Context,
 	query string,
 	params *table.QueryParameters,
+	opts ...options.ExecuteDataQueryOption,
 ) (r result.Result, err error) {
 	var (
 		onDone  = trace.ScriptingOnExecute(c.config.Trace(), &ctx, query, params)
@@ -81,6 +93,7 @@ func (c *Client) execute(
 		a.Free()
 		onDone(r, err)
 	}()
+	applyExecuteOptions(request, opts...)
 	response, err = c.service.ExecuteYql(ctx, request)
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
@@ -93,6 +106,32 @@ func (c *Client) execute(
 	return scanner.NewUnary(result.GetResultSets(), result.GetQueryStats()), nil
 }
 
+// applyExecuteOptions applies data query options (such as options.WithAutoDeclare and collect stats mode)
+// to scripting request. Options which not supported by scripting request are ignored
+func applyExecuteOptions(request *Ydb_Scripting.ExecuteYqlRequest, opts ...options.ExecuteDataQueryOption) {
+	if len(opts) == 0 {
+		return
+	}
+	desc := options.ExecuteDataQueryDesc{
+		ExecuteDataQueryRequest: Ydb_Table.ExecuteDataQueryRequest{
+			Query: &Ydb_Table.Query{
+				Query: &Ydb_Table.Query_YqlText{
+					YqlText: request.GetScript(),
+				},
+			},
+			Parameters:   request.GetParameters(),
+			CollectStats: request.GetCollectStats(),
+		},
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&desc)
+		}
+	}
+	request.Script = desc.Query.GetYqlText()
+	request.CollectStats = desc.CollectStats
+}
+
 func mode2mode(mode scripting.ExplainMode) Ydb_Scripting.ExplainYqlRequest_Mode {
 	switch mode {
 	case scripting.ExplainModePlan:
@@ -172,12 +211,21 @@ func (c *Client) StreamExecute(
 	ctx context.Context,
 	query string,
 	params *table.QueryParameters,
+) (r result.StreamResult, err error) {
+	return c.StreamExecuteWithOptions(ctx, query, params)
+}
+
+func (c *Client) StreamExecuteWithOptions(
+	ctx context.Context,
+	query string,
+	params *table.QueryParameters,
+	opts ...options.ExecuteDataQueryOption,
 ) (r result.StreamResult, err error) {
 	if c == nil {
 		return r, xerrors.WithStackTrace(errNilClient)
 	}
 	call := func(ctx context.Context) error {
-		r, err = c.streamExecute(ctx, query, params)
+		r, err = c.streamExecute(ctx, query, params, opts...)
 		return xerrors.WithStackTrace(err)
 	}
 	if !c.config.AutoRetry() {
@@ -192,6 +240,7 @@ func (c *Client) streamExecute(
 	ctx context.Context,
 	query string,
 	params *table.QueryParameters,
+	opts ...options.ExecuteDataQueryOption,
 ) (r result.StreamResult, err error) {
 	var (
 		onIntermediate = trace.ScriptingOnStreamExecute(c.config.Trace(), &ctx, query, params)
@@ -214,6 +263,8 @@ func (c *Client) streamExecute(
 		}
 	}()
 
+	applyExecuteOptions(request, opts...)
+
 	ctx, cancel := context.WithCancel(ctx)
 
 	stream, err := c.service.StreamExecuteYql(ctx, request)
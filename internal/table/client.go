@@ -10,6 +10,7 @@ import (
 
 	"google.golang.org/grpc"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/meta"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
@@ -22,6 +23,9 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
+// minIdleRetryInterval is an interval between attempts of background filling of idle sessions after failure
+const minIdleRetryInterval = time.Second
+
 type sessionBuilderOption func(s *session)
 
 // sessionBuilder is the interface that holds logic of creating sessions.
@@ -56,12 +60,25 @@ func newClient(
 				return &ch
 			},
 		},
-		done: make(chan struct{}),
+		done:    make(chan struct{}),
+		minIdle: config.MinIdleSize(),
+		nodes:   make(map[uint32]struct{}),
+
+		createInProgressOnNode: make(map[uint32]int),
+		fill:                   make(chan struct{}, 1),
 	}
 	if idleThreshold := config.IdleThreshold(); idleThreshold > 0 {
 		c.spawnedGoroutines.Add(1)
 		go c.internalPoolGC(ctx, idleThreshold)
 	}
+	if c.minIdle > 0 {
+		c.spawnedGoroutines.Add(1)
+		go c.internalPoolKeepMinIdle(ctx)
+	}
+	if rebalanceInterval := config.RebalanceInterval(); rebalanceInterval > 0 {
+		c.spawnedGoroutines.Add(1)
+		go c.internalPoolRebalance(ctx, rebalanceInterval)
+	}
 	onDone(c.limit)
 	return c
 }
@@ -74,18 +91,27 @@ type Client struct {
 	build  sessionBuilder
 	cc     grpc.ClientConnInterface
 
+	minIdle int // Target count of idle sessions.
+
 	// read-write fields
 	mu                xsync.Mutex
 	index             map[*session]sessionInfo
-	createInProgress  int        // KIKIMR-9163: in-create-process counter
-	limit             int        // Upper bound for Client size.
-	idle              *list.List // list<*session>
-	waitq             *list.List // list<*chan *session>
+	createInProgress  int           // KIKIMR-9163: in-create-process counter
+	waitTotal         time.Duration // Summary latency of successful gets from pool
+	waitCount         int64         // Count of successful gets from pool
+	limit             int           // Upper bound for Client size.
+	idle              *list.List    // list<*session>
+	waitq             *list.List    // list<*chan *session>
 	waitChPool        sync.Pool
 	testHookGetWaitCh func() // nil except some tests.
 	spawnedGoroutines sync.WaitGroup
 	closed            uint32
 	done              chan struct{}
+	fillMu            sync.Mutex    // Serializes filling of idle sessions up to minIdle.
+	fill              chan struct{} // Wakes up background filling of idle sessions.
+
+	nodes                  map[uint32]struct{} // Discovered nodes for node-aware placement.
+	createInProgressOnNode map[uint32]int      // in-create-process counters of explicitly placed sessions
 }
 
 type createSessionOptions struct {
@@ -261,6 +287,59 @@ func (c *Client) isClosed() bool {
 
 // c.mu must NOT be held.
 func (c *Client) internalPoolCreateSession(ctx context.Context) (s *session, err error) {
+	if c.config.SessionsPerNodeLimit() == 0 {
+		return c.internalPoolCreateSessionOnNode(ctx, 0)
+	}
+	var (
+		nodeID uint32
+		ok     bool
+	)
+	c.mu.WithLock(func() {
+		nodeID, ok = c.internalPoolPlacement()
+		if ok && nodeID != 0 {
+			c.createInProgressOnNode[nodeID]++
+		}
+	})
+	if !ok {
+		return nil, xerrors.WithStackTrace(errSessionPoolOverflow)
+	}
+	return c.internalPoolCreateSessionReserved(ctx, nodeID)
+}
+
+// internalPoolCreateSessionReserved creates session on node with nodeID which reserved
+// in createInProgressOnNode by caller, releases reservation and checks limit of sessions per node.
+// Session which overflows limit of sessions per node is closed
+// c.mu must NOT be held.
+func (c *Client) internalPoolCreateSessionReserved(ctx context.Context, nodeID uint32) (s *session, err error) {
+	s, err = c.internalPoolCreateSessionOnNode(ctx, nodeID)
+	var overflow bool
+	c.mu.WithLock(func() {
+		// created session already is in index, so reservation of node released
+		// before checking of limit for not counting session twice
+		if nodeID != 0 {
+			c.createInProgressOnNode[nodeID]--
+			if c.createInProgressOnNode[nodeID] == 0 {
+				delete(c.createInProgressOnNode, nodeID)
+			}
+		}
+		if limit := c.config.SessionsPerNodeLimit(); err == nil && limit > 0 {
+			overflow = c.internalPoolSessionsOnNode(s.NodeID()) > limit
+		}
+	})
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	if overflow {
+		c.internalPoolAsyncCloseSession(ctx, s)
+		return nil, xerrors.WithStackTrace(errSessionPoolOverflow)
+	}
+	return s, nil
+}
+
+// internalPoolCreateSessionOnNode creates session on node with nodeID.
+// Zero nodeID means that node of session is defined by server-side session balancer
+// c.mu must NOT be held.
+func (c *Client) internalPoolCreateSessionOnNode(ctx context.Context, nodeID uint32) (s *session, err error) {
 	if c.isClosed() {
 		return nil, errClosedClient
 	}
@@ -283,9 +362,15 @@ func (c *Client) internalPoolCreateSession(ctx context.Context) (s *session, err
 		})
 	}()
 
-	s, err = c.createSession(meta.WithAllowFeatures(ctx,
-		meta.HintSessionBalancer,
-	), withCreateSessionOnCreate(func(s *session) {
+	if nodeID != 0 {
+		ctx = balancer.WithNodeID(ctx, nodeID)
+	} else {
+		ctx = meta.WithAllowFeatures(ctx,
+			meta.HintSessionBalancer,
+		)
+	}
+
+	s, err = c.createSession(ctx, withCreateSessionOnCreate(func(s *session) {
 		c.mu.WithLock(func() {
 			c.index[s] = sessionInfo{
 				touched: timeutil.Now(),
@@ -312,6 +397,8 @@ func (c *Client) internalPoolCreateSession(ctx context.Context) (s *session, err
 			if info.idle != nil {
 				c.idle.Remove(info.idle)
 			}
+
+			c.internalPoolSignalFill()
 		})
 	}))
 	if err != nil {
@@ -351,6 +438,15 @@ func (c *Client) internalPoolGet(ctx context.Context, opts ...getOption) (s *ses
 		onDone(s, i, err)
 	}()
 
+	defer func() {
+		if s != nil && err == nil {
+			c.mu.WithLock(func() {
+				c.waitTotal += time.Since(start)
+				c.waitCount++
+			})
+		}
+	}()
+
 	const maxAttempts = 100
 	for s == nil && err == nil && i < maxAttempts && !c.isClosed() {
 		i++
@@ -415,6 +511,30 @@ func (c *Client) internalPoolGet(ctx context.Context, opts ...getOption) (s *ses
 	return s, nil
 }
 
+// Stats returns snapshot of session pool state
+func (c *Client) Stats() (stats table.Stats) {
+	if c == nil {
+		return stats
+	}
+	c.mu.WithLock(func() {
+		stats = table.Stats{
+			Limit:            c.limit,
+			Idle:             c.idle.Len(),
+			InUse:            len(c.index) - c.idle.Len(),
+			Waiters:          c.waitq.Len(),
+			CreateInProgress: c.createInProgress,
+			SessionsPerNode:  make(map[uint32]int),
+		}
+		for s := range c.index {
+			stats.SessionsPerNode[s.NodeID()]++
+		}
+		if c.waitCount > 0 {
+			stats.AverageWait = c.waitTotal / time.Duration(c.waitCount)
+		}
+	})
+	return stats
+}
+
 // Get returns first idle session from the Client and removes it from
 // there. If no items stored in Client it creates new one returns it.
 func (c *Client) Get(ctx context.Context) (s *session, err error) {
@@ -619,7 +739,10 @@ func (c *Client) internalPoolGC(ctx context.Context, idleThreshold time.Duration
 
 		case <-timer.C():
 			c.mu.WithLock(func() {
-				for e := c.idle.Front(); e != nil; e = e.Next() {
+				// idle sessions are ordered by time of putting into pool, so the oldest
+				// sessions are closed first and the newest minIdle sessions are kept
+				closing := 0
+				for e := c.idle.Front(); e != nil && c.idle.Len()-closing > c.minIdle; e = e.Next() {
 					s := e.Value.(*session)
 					info, has := c.index[s]
 					if !has {
@@ -628,8 +751,9 @@ func (c *Client) internalPoolGC(ctx context.Context, idleThreshold time.Duration
 					if info.idle == nil {
 						panic("inconsistent session info")
 					}
-					if since := timeutil.Until(info.touched); since > idleThreshold {
+					if since := timeutil.Since(info.touched); since > idleThreshold {
 						c.internalPoolAsyncCloseSession(ctx, s)
+						closing++
 					}
 				}
 			})
@@ -686,10 +810,87 @@ func (c *Client) internalPoolRemoveFirstIdle() *session {
 	if s != nil {
 		info := c.internalPoolRemoveIdle(s)
 		c.index[s] = info
+		c.internalPoolSignalFill()
 	}
 	return s
 }
 
+// internalPoolSignalFill wakes up background filling of idle sessions up to minIdle
+func (c *Client) internalPoolSignalFill() {
+	if c.minIdle == 0 {
+		return
+	}
+	select {
+	case c.fill <- struct{}{}:
+	default:
+	}
+}
+
+// internalPoolFill creates idle sessions until count of idle sessions reaches minIdle
+// or pool is full
+// c.mu must NOT be held.
+func (c *Client) internalPoolFill(ctx context.Context) error {
+	c.fillMu.Lock()
+	defer c.fillMu.Unlock()
+
+	for {
+		var need bool
+		c.mu.WithLock(func() {
+			need = c.idle.Len() < c.minIdle && len(c.index)+c.createInProgress < c.limit
+		})
+		if !need {
+			return nil
+		}
+		s, err := c.internalPoolCreateSession(ctx)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		if err = c.Put(ctx, s); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+	}
+}
+
+// internalPoolKeepMinIdle keeps up count of idle sessions in the background
+func (c *Client) internalPoolKeepMinIdle(ctx context.Context) {
+	defer c.spawnedGoroutines.Done()
+
+	timer := timeutil.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-c.done:
+			return
+		case <-c.fill:
+		case <-timer.C():
+		}
+		if err := c.internalPoolFill(ctx); err != nil {
+			// retry filling later, sessions still will be created on demand
+			timer.Reset(minIdleRetryInterval)
+		}
+	}
+}
+
+// Warmup creates idle sessions up to minimum idle size of pool and blocks until pool is ready.
+// Pool which overflowed while filling (for example, with concurrently used sessions) is at capacity,
+// so it is ready
+func (c *Client) Warmup(ctx context.Context) error {
+	if c == nil {
+		return xerrors.WithStackTrace(errNilClient)
+	}
+	if c.isClosed() {
+		return xerrors.WithStackTrace(errClosedClient)
+	}
+	return retry.Retry(ctx, func(ctx context.Context) error {
+		err := c.internalPoolFill(ctx)
+		if xerrors.Is(err, errSessionPoolOverflow) {
+			return nil
+		}
+		return err
+	}, retry.WithIdempotent(true), retry.WithID("Warmup"))
+}
+
 // c.mu must be held.
 func (c *Client) internalPoolNotify(s *session) (notified bool) {
 	for el := c.waitq.Front(); el != nil; el = c.waitq.Front() {
@@ -6,6 +6,7 @@ import (
 	"math/rand"
 	"path"
 	"runtime"
+	"strconv"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -16,6 +17,7 @@ import (
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/endpoint"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xrand"
@@ -733,6 +735,63 @@ func TestSessionPoolCloseIdleSessions(t *testing.T) {
 	}, xtest.StopAfter(12*time.Second))
 }
 
+func TestSessionPoolCloseIdleSessionsKeepsMinIdle(t *testing.T) {
+	timers, cleanupTimers := timetest.StubTimers(t, "internalPoolGC", "internalPoolKeepMinIdle")
+	defer cleanupTimers()
+
+	shiftTime, cleanupTime := timeutil.StubTestHookTimeNow(time.Unix(0, 0))
+	defer cleanupTime()
+
+	idleThreshold := 4 * time.Second
+	p := newClientWithStubBuilder(
+		t,
+		testutil.NewBalancer(
+			testutil.WithInvokeHandlers(
+				testutil.InvokeHandlers{
+					testutil.TableDeleteSession: okHandler,
+					testutil.TableCreateSession: func(interface{}) (proto.Message, error) {
+						return &Ydb_Table.CreateSessionResult{
+							SessionId: testutil.SessionID(),
+						}, nil
+					},
+				},
+			),
+		),
+		3,
+		config.WithSizeLimit(3),
+		config.WithMinIdleSize(2),
+		config.WithIdleThreshold(idleThreshold),
+	)
+	defer func() {
+		_ = p.Close(context.Background())
+	}()
+
+	gc := timers["internalPoolGC"]
+	if interval := <-gc.Created; interval != idleThreshold {
+		t.Fatalf("unexpected ticker duration: %s; want %s", interval, idleThreshold)
+	}
+
+	sessions := []*session{
+		mustGetSession(t, p),
+		mustGetSession(t, p),
+		mustGetSession(t, p),
+	}
+	for _, s := range sessions {
+		mustPutSession(t, p, s)
+	}
+
+	shiftTime(idleThreshold + time.Second)
+	gc.C <- timeutil.Now()
+	mustResetTimer(t, gc.Reset, idleThreshold/2)
+
+	for i, s := range sessions {
+		closing := s.isClosing() || s.isClosed()
+		if oldest := i == 0; closing != oldest {
+			t.Errorf("unexpected closing of session %d: %v", i, closing)
+		}
+	}
+}
+
 func TestSessionPoolDoublePut(t *testing.T) {
 	p := newClientWithStubBuilder(
 		t,
@@ -939,3 +998,264 @@ func whenWantWaitCh(p *Client) <-chan struct{} {
 	}
 	return ch
 }
+
+func TestSessionPoolStats(t *testing.T) {
+	p := newClientWithStubBuilder(
+		t,
+		testutil.NewBalancer(
+			testutil.WithInvokeHandlers(
+				testutil.InvokeHandlers{
+					testutil.TableCreateSession: func(interface{}) (proto.Message, error) {
+						return &Ydb_Table.CreateSessionResult{
+							SessionId: testutil.SessionID(),
+						}, nil
+					},
+					testutil.TableDeleteSession: func(interface{}) (proto.Message, error) {
+						return nil, nil
+					},
+				},
+			),
+		),
+		0,
+		config.WithSizeLimit(3),
+	)
+	defer func() {
+		_ = p.Close(context.Background())
+	}()
+
+	if stats := p.Stats(); stats.Limit != 3 || stats.Idle != 0 || stats.InUse != 0 || stats.AverageWait != 0 {
+		t.Fatalf("unexpected stats of empty pool: %+v", stats)
+	}
+
+	s1 := mustGetSession(t, p)
+	mustGetSession(t, p)
+	mustPutSession(t, p, s1)
+
+	stats := p.Stats()
+	if stats.Idle != 1 || stats.InUse != 1 || stats.Waiters != 0 || stats.CreateInProgress != 0 {
+		t.Fatalf("unexpected stats: %+v", stats)
+	}
+	if stats.AverageWait <= 0 {
+		t.Fatalf("unexpected average wait: %v", stats.AverageWait)
+	}
+	total := 0
+	for _, n := range stats.SessionsPerNode {
+		total += n
+	}
+	if total != 2 {
+		t.Fatalf("unexpected sessions per node: %v", stats.SessionsPerNode)
+	}
+}
+
+func TestSessionPoolSessionsPerNodeLimit(t *testing.T) {
+	for _, limit := range []int{1, 2, 3} {
+		t.Run(strconv.Itoa(limit), func(t *testing.T) {
+			p := newClientWithStubBuilder(
+				t,
+				testutil.NewBalancer(
+					testutil.WithInvokeHandlers(
+						testutil.InvokeHandlers{
+							testutil.TableCreateSession: func(interface{}) (proto.Message, error) {
+								return &Ydb_Table.CreateSessionResult{
+									SessionId: testutil.SessionID(testutil.WithNodeID(1)),
+								}, nil
+							},
+							testutil.TableDeleteSession: okHandler,
+						},
+					),
+				),
+				0,
+				config.WithSizeLimit(10),
+				config.WithSessionsPerNodeLimit(limit),
+			)
+			defer func() {
+				_ = p.Close(context.Background())
+			}()
+			p.UpdateNodes([]endpoint.Info{
+				endpoint.New("a:2135", endpoint.WithID(1)),
+			})
+
+			for i := 0; i < limit; i++ {
+				if _, err := p.internalPoolCreateSession(context.Background()); err != nil {
+					t.Fatalf("unexpected error on creating of session %d: %v", i, err)
+				}
+			}
+			if _, err := p.internalPoolCreateSession(context.Background()); !xerrors.Is(err, errSessionPoolOverflow) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if stats := p.Stats(); stats.SessionsPerNode[1] != limit {
+				t.Fatalf("unexpected sessions per node: %v", stats.SessionsPerNode)
+			}
+		})
+	}
+}
+
+func TestSessionPoolWarmupOverflow(t *testing.T) {
+	p := newClientWithStubBuilder(
+		t,
+		testutil.NewBalancer(
+			testutil.WithInvokeHandlers(
+				testutil.InvokeHandlers{
+					testutil.TableCreateSession: func(interface{}) (proto.Message, error) {
+						return &Ydb_Table.CreateSessionResult{
+							SessionId: testutil.SessionID(testutil.WithNodeID(1)),
+						}, nil
+					},
+					testutil.TableDeleteSession: okHandler,
+				},
+			),
+		),
+		0,
+		config.WithSizeLimit(5),
+		config.WithMinIdleSize(3),
+		config.WithSessionsPerNodeLimit(1),
+	)
+	defer func() {
+		_ = p.Close(context.Background())
+	}()
+	p.UpdateNodes([]endpoint.Info{
+		endpoint.New("a:2135", endpoint.WithID(1)),
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	// single node is full after first session, so pool is at capacity
+	if err := p.Warmup(ctx); err != nil {
+		t.Fatalf("unexpected warmup error: %v", err)
+	}
+}
+
+func TestSessionPoolRebalanceOnce(t *testing.T) {
+	var (
+		p             *Client
+		nodeIDs       = []uint32{1, 1, 2, 2}
+		failCreate    bool
+		reservedOnTo  int
+		creatingCount int
+	)
+	p = newClientWithStubBuilder(
+		t,
+		testutil.NewBalancer(
+			testutil.WithInvokeHandlers(
+				testutil.InvokeHandlers{
+					testutil.TableCreateSession: func(interface{}) (proto.Message, error) {
+						if failCreate {
+							return nil, fmt.Errorf("create session failed")
+						}
+						nodeID := nodeIDs[creatingCount]
+						creatingCount++
+						if nodeID == 2 {
+							p.mu.WithLock(func() {
+								reservedOnTo = p.createInProgressOnNode[2]
+							})
+						}
+						return &Ydb_Table.CreateSessionResult{
+							SessionId: testutil.SessionID(testutil.WithNodeID(nodeID)),
+						}, nil
+					},
+					testutil.TableDeleteSession: okHandler,
+				},
+			),
+		),
+		0,
+		config.WithSizeLimit(10),
+		config.WithSessionsPerNodeLimit(2),
+	)
+	defer func() {
+		_ = p.Close(context.Background())
+	}()
+	p.UpdateNodes([]endpoint.Info{
+		endpoint.New("a:2135", endpoint.WithID(1)),
+	})
+	for i := 0; i < 2; i++ {
+		s, err := p.internalPoolCreateSession(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = p.Put(context.Background(), s); err != nil {
+			t.Fatal(err)
+		}
+	}
+	p.UpdateNodes([]endpoint.Info{
+		endpoint.New("a:2135", endpoint.WithID(1)),
+		endpoint.New("b:2135", endpoint.WithID(2)),
+	})
+
+	// failed creation of new session keeps idle session of overloaded node
+	failCreate = true
+	if err := p.internalPoolRebalanceOnce(context.Background()); err == nil {
+		t.Fatal("expected error of rebalance")
+	}
+	if stats := p.Stats(); stats.Idle != 2 || stats.SessionsPerNode[1] != 2 || stats.SessionsPerNode[2] != 0 {
+		t.Fatalf("unexpected stats after failed rebalance: %+v", stats)
+	}
+
+	failCreate = false
+	if err := p.internalPoolRebalanceOnce(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if reservedOnTo != 1 {
+		t.Fatalf("new session on underloaded node created without reservation: %d", reservedOnTo)
+	}
+	if stats := p.Stats(); stats.Idle != 2 || stats.SessionsPerNode[1] != 1 || stats.SessionsPerNode[2] != 1 {
+		t.Fatalf("unexpected stats after rebalance: %+v", stats)
+	}
+}
+
+func TestSessionPoolWarmup(t *testing.T) {
+	p := newClientWithStubBuilder(
+		t,
+		testutil.NewBalancer(
+			testutil.WithInvokeHandlers(
+				testutil.InvokeHandlers{
+					testutil.TableCreateSession: func(interface{}) (proto.Message, error) {
+						return &Ydb_Table.CreateSessionResult{
+							SessionId: testutil.SessionID(),
+						}, nil
+					},
+					testutil.TableDeleteSession: func(interface{}) (proto.Message, error) {
+						return nil, nil
+					},
+				},
+			),
+		),
+		0,
+		config.WithSizeLimit(3),
+		config.WithMinIdleSize(2),
+	)
+	defer func() {
+		_ = p.Close(context.Background())
+	}()
+
+	if err := p.Warmup(context.Background()); err != nil {
+		t.Fatalf("unexpected warmup error: %v", err)
+	}
+	if stats := p.Stats(); stats.Idle != 2 || stats.InUse != 0 {
+		t.Fatalf("unexpected stats after warmup: %+v", stats)
+	}
+
+	mustGetSession(t, p)
+
+	// background filling restores idle sessions up to min idle size
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		stats := p.Stats()
+		if stats.Idle == 2 && stats.InUse == 1 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("idle sessions not restored: %+v", stats)
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	mustGetSession(t, p)
+
+	// pool is full, so idle sessions cannot be restored
+	if err := p.Warmup(context.Background()); err != nil {
+		t.Fatalf("unexpected warmup error: %v", err)
+	}
+	if stats := p.Stats(); stats.Idle+stats.InUse+stats.CreateInProgress > 3 {
+		t.Fatalf("pool overflow: %+v", stats)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
@@ -51,6 +52,44 @@ func WithSizeLimit(sizeLimit int) Option {
 	}
 }
 
+// WithMinIdleSize defines target count of idle sessions which pool creates eagerly at startup
+// and keeps up in the background. MinIdleSize bounded with SizeLimit.
+// If minIdleSize is less than or equal to zero then pool creates sessions only on demand.
+func WithMinIdleSize(minIdleSize int) Option {
+	return func(c *Config) {
+		if minIdleSize < 0 {
+			minIdleSize = 0
+		}
+		c.minIdleSize = minIdleSize
+	}
+}
+
+// WithSessionsPerNodeLimit defines upper bound of pooled sessions on each YDB node.
+// Pool places new sessions on the least loaded known node if limit defined.
+// If sessionsPerNodeLimit is less than or equal to zero then sessions count on node is not limited
+// and sessions placement is defined by server-side session balancer.
+func WithSessionsPerNodeLimit(sessionsPerNodeLimit int) Option {
+	return func(c *Config) {
+		if sessionsPerNodeLimit < 0 {
+			sessionsPerNodeLimit = 0
+		}
+		c.sessionsPerNodeLimit = sessionsPerNodeLimit
+	}
+}
+
+// WithRebalanceInterval defines interval of rotating idle sessions from overloaded nodes
+// toward underloaded (such as newly discovered) nodes. Each rebalance iteration rotates
+// not more than one session.
+// If rebalanceInterval is less than or equal to zero then rebalancing is disabled.
+func WithRebalanceInterval(rebalanceInterval time.Duration) Option {
+	return func(c *Config) {
+		if rebalanceInterval < 0 {
+			rebalanceInterval = 0
+		}
+		c.rebalanceInterval = rebalanceInterval
+	}
+}
+
 // WithKeepAliveMinSize defines lower bound for sessions in the pool. If there are more sessions open, then
 // the excess idle ones will be closed and removed after IdleKeepAliveThreshold is reached for each of them.
 // If keepAliveMinSize is less than zero, then no sessions will be preserved
@@ -131,18 +170,38 @@ func WithIgnoreTruncated() Option {
 	}
 }
 
+// WithRetryBudget limits retries of table client calls (Do and DoTx) with shared retry budget
+func WithRetryBudget(budget *retry.Budget) Option {
+	return func(c *Config) {
+		c.retryBudget = budget
+	}
+}
+
+// WithRetryPolicy replaces default retry policy of table client calls (Do and DoTx)
+func WithRetryPolicy(policy retry.Policy) Option {
+	return func(c *Config) {
+		c.retryPolicy = policy
+	}
+}
+
 // Config is a configuration of table client
 type Config struct {
 	config.Common
 
-	sizeLimit int
+	sizeLimit            int
+	minIdleSize          int
+	sessionsPerNodeLimit int
 
 	createSessionTimeout time.Duration
 	deleteTimeout        time.Duration
 	idleThreshold        time.Duration
+	rebalanceInterval    time.Duration
 
 	ignoreTruncated bool
 
+	retryBudget *retry.Budget
+	retryPolicy retry.Policy
+
 	trace trace.Table
 }
 
@@ -158,6 +217,27 @@ func (c Config) SizeLimit() int {
 	return c.sizeLimit
 }
 
+// MinIdleSize is a target count of idle sessions which pool creates eagerly and keeps up in the background.
+// MinIdleSize is not greater than SizeLimit
+func (c Config) MinIdleSize() int {
+	if c.minIdleSize > c.sizeLimit {
+		return c.sizeLimit
+	}
+	return c.minIdleSize
+}
+
+// SessionsPerNodeLimit is an upper bound of pooled sessions on each YDB node.
+// Zero value means unlimited sessions count on node
+func (c Config) SessionsPerNodeLimit() int {
+	return c.sessionsPerNodeLimit
+}
+
+// RebalanceInterval is an interval of rotating idle sessions toward underloaded nodes.
+// Zero value means rebalancing is disabled
+func (c Config) RebalanceInterval() time.Duration {
+	return c.rebalanceInterval
+}
+
 // KeepAliveMinSize is a lower bound for sessions in the pool. If there are more sessions open, then
 // the excess idle ones will be closed and removed after IdleKeepAliveThreshold is reached for each of them.
 // If KeepAliveMinSize is less than zero, then no sessions will be preserved
@@ -209,6 +289,18 @@ func (c Config) CreateSessionTimeout() time.Duration {
 	return c.createSessionTimeout
 }
 
+// RetryBudget is a shared retry budget of table client calls.
+// Nil value means retries of calls are not limited with budget
+func (c Config) RetryBudget() *retry.Budget {
+	return c.retryBudget
+}
+
+// RetryPolicy is a retry policy of table client calls.
+// Nil value means default retry policy with backoffs from options of call
+func (c Config) RetryPolicy() retry.Policy {
+	return c.retryPolicy
+}
+
 // DeleteTimeout limits maximum time spent on Delete request
 //
 // If DeleteTimeout is less than or equal to zero then the DefaultSessionPoolDeleteTimeout is used.
@@ -31,6 +31,10 @@ var (
 	// errNoProgress returned by a Client instance to indicate that
 	// operation could not be completed.
 	errNoProgress = xerrors.Wrap(errors.New("no progress"))
+
+	// errParamsMismatch returned by a prepared statement with auto declare option
+	// to indicate that query parameters not matches to declared parameters of statement.
+	errParamsMismatch = errors.New("query parameters not matches to declared parameters of statement")
 )
 
 func isCreateSessionErrorRetriable(err error) bool {
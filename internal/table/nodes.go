@@ -0,0 +1,230 @@
+package table
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/endpoint"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/testutil/timeutil"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+// UpdateNodes applies discovered endpoints to node-aware placement of sessions
+func (c *Client) UpdateNodes(endpoints []endpoint.Info) {
+	if c == nil {
+		return
+	}
+	nodes := make(map[uint32]struct{}, len(endpoints))
+	for _, e := range endpoints {
+		if nodeID := e.NodeID(); nodeID != 0 {
+			nodes[nodeID] = struct{}{}
+		}
+	}
+	var all, added, removed []uint32
+	c.mu.WithLock(func() {
+		for nodeID := range nodes {
+			all = append(all, nodeID)
+			if _, has := c.nodes[nodeID]; !has {
+				added = append(added, nodeID)
+			}
+		}
+		for nodeID := range c.nodes {
+			if _, has := nodes[nodeID]; !has {
+				removed = append(removed, nodeID)
+			}
+		}
+		c.nodes = nodes
+	})
+	if len(added) == 0 && len(removed) == 0 {
+		return
+	}
+	sortNodeIDs(all)
+	sortNodeIDs(added)
+	sortNodeIDs(removed)
+	trace.TableOnPoolNodesUpdate(c.config.Trace(), all, added, removed)
+}
+
+func sortNodeIDs(nodeIDs []uint32) {
+	sort.Slice(nodeIDs, func(i, j int) bool {
+		return nodeIDs[i] < nodeIDs[j]
+	})
+}
+
+// internalPoolSessionsOnNode returns count of sessions (include creating) on node
+// c.mu must be held.
+func (c *Client) internalPoolSessionsOnNode(nodeID uint32) (count int) {
+	for s := range c.index {
+		if s.NodeID() == nodeID {
+			count++
+		}
+	}
+	return count + c.createInProgressOnNode[nodeID]
+}
+
+// internalPoolSessionsPerNode returns count of sessions (include creating) on each node
+// c.mu must be held.
+func (c *Client) internalPoolSessionsPerNode() map[uint32]int {
+	counts := make(map[uint32]int, len(c.nodes))
+	for nodeID, count := range c.createInProgressOnNode {
+		counts[nodeID] += count
+	}
+	for s := range c.index {
+		counts[s.NodeID()]++
+	}
+	return counts
+}
+
+// internalPoolKnownNodes returns sorted discovered node IDs
+// c.mu must be held.
+func (c *Client) internalPoolKnownNodes() []uint32 {
+	nodes := make([]uint32, 0, len(c.nodes))
+	for nodeID := range c.nodes {
+		nodes = append(nodes, nodeID)
+	}
+	sortNodeIDs(nodes)
+	return nodes
+}
+
+// internalPoolPlacement returns the least loaded discovered node which has space for new session.
+// Zero nodeID with true means that nodes are unknown and placement is defined by server-side session balancer.
+// False means that all nodes are full
+// c.mu must be held.
+func (c *Client) internalPoolPlacement() (nodeID uint32, ok bool) {
+	if len(c.nodes) == 0 {
+		return 0, true
+	}
+	var (
+		counts = c.internalPoolSessionsPerNode()
+		limit  = c.config.SessionsPerNodeLimit()
+	)
+	for _, id := range c.internalPoolKnownNodes() {
+		if limit > 0 && counts[id] >= limit {
+			continue
+		}
+		if !ok || counts[id] < counts[nodeID] {
+			nodeID, ok = id, true
+		}
+	}
+	return nodeID, ok
+}
+
+// internalPoolRebalanceNodes returns pair of nodes for rotating of one session.
+// Sessions on undiscovered (removed) nodes are rotated first
+// c.mu must be held.
+func (c *Client) internalPoolRebalanceNodes() (from, to uint32, ok bool) {
+	if len(c.nodes) == 0 {
+		return 0, 0, false
+	}
+	var (
+		counts = c.internalPoolSessionsPerNode()
+		known  = c.internalPoolKnownNodes()
+		limit  = c.config.SessionsPerNodeLimit()
+		hasTo  bool
+	)
+	for _, id := range known {
+		if limit > 0 && counts[id] >= limit {
+			continue
+		}
+		if !hasTo || counts[id] < counts[to] {
+			to, hasTo = id, true
+		}
+	}
+	if !hasTo {
+		return 0, 0, false
+	}
+	for id, count := range counts {
+		if _, has := c.nodes[id]; !has && id != 0 && count > 0 && (!ok || count > counts[from]) {
+			from, ok = id, true
+		}
+	}
+	if ok {
+		return from, to, true
+	}
+	for _, id := range known {
+		if !ok || counts[id] > counts[from] {
+			from, ok = id, true
+		}
+	}
+	if !ok || counts[from]-counts[to] <= 1 {
+		return 0, 0, false
+	}
+	return from, to, true
+}
+
+// internalPoolRebalance rotates idle sessions toward underloaded nodes in the background
+func (c *Client) internalPoolRebalance(ctx context.Context, interval time.Duration) {
+	defer c.spawnedGoroutines.Done()
+
+	timer := timeutil.NewTimer(interval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-c.done:
+			return
+
+		case <-timer.C():
+			_ = c.internalPoolRebalanceOnce(ctx)
+			timer.Reset(interval)
+		}
+	}
+}
+
+// internalPoolRebalanceOnce replaces one idle session on overloaded node with new session on underloaded node.
+// Idle session is closed only after new session created, so failed rebalance not shrinks the pool
+// c.mu must NOT be held.
+func (c *Client) internalPoolRebalanceOnce(ctx context.Context) (err error) {
+	var (
+		s        *session
+		from, to uint32
+	)
+	c.mu.WithLock(func() {
+		var ok bool
+		from, to, ok = c.internalPoolRebalanceNodes()
+		if !ok {
+			return
+		}
+		for el := c.idle.Front(); el != nil; el = el.Next() {
+			if idle := el.Value.(*session); idle.NodeID() == from {
+				s = idle
+				break
+			}
+		}
+		if s != nil {
+			c.createInProgressOnNode[to]++
+		}
+	})
+	if s == nil {
+		return nil
+	}
+
+	var created *session
+	onDone := trace.TableOnPoolRebalance(c.config.Trace(), &ctx, s, from, to)
+	defer func() {
+		if created == nil {
+			onDone(nil, err)
+		} else {
+			onDone(created, err)
+		}
+	}()
+
+	created, err = c.internalPoolCreateSessionReserved(ctx, to)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	var idle bool
+	c.mu.WithLock(func() {
+		// idle session may be taken from pool while new session was creating
+		if info, has := c.index[s]; has && info.idle != nil {
+			c.internalPoolRemoveIdle(s)
+			idle = true
+		}
+	})
+	if idle {
+		c.internalPoolSyncCloseSession(ctx, s)
+	}
+	return c.Put(ctx, created)
+}
@@ -0,0 +1,108 @@
+package table
+
+import (
+	"container/list"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/endpoint"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+func newNodesTestClient(opts ...config.Option) *Client {
+	return &Client{
+		config:                 config.New(opts...),
+		index:                  make(map[*session]sessionInfo),
+		idle:                   list.New(),
+		nodes:                  make(map[uint32]struct{}),
+		createInProgressOnNode: make(map[uint32]int),
+	}
+}
+
+func addSessionsOnNode(c *Client, nodeID uint32, count int) {
+	for i := 0; i < count; i++ {
+		s := &session{nodeID: nodeID}
+		c.index[s] = sessionInfo{}
+	}
+}
+
+func TestUpdateNodes(t *testing.T) {
+	var updates []trace.TablePoolNodesUpdateInfo
+	c := newNodesTestClient(config.WithTrace(trace.Table{
+		OnPoolNodesUpdate: func(info trace.TablePoolNodesUpdateInfo) {
+			updates = append(updates, info)
+		},
+	}))
+	c.UpdateNodes([]endpoint.Info{
+		endpoint.New("b:2135", endpoint.WithID(2)),
+		endpoint.New("a:2135", endpoint.WithID(1)),
+	})
+	c.UpdateNodes([]endpoint.Info{
+		endpoint.New("a:2135", endpoint.WithID(1)),
+		endpoint.New("b:2135", endpoint.WithID(2)),
+	})
+	c.UpdateNodes([]endpoint.Info{
+		endpoint.New("b:2135", endpoint.WithID(2)),
+		endpoint.New("c:2135", endpoint.WithID(3)),
+	})
+	require.Equal(t, []trace.TablePoolNodesUpdateInfo{
+		{
+			Nodes: []uint32{1, 2},
+			Added: []uint32{1, 2},
+		},
+		{
+			Nodes:   []uint32{2, 3},
+			Added:   []uint32{3},
+			Removed: []uint32{1},
+		},
+	}, updates)
+}
+
+func TestPlacement(t *testing.T) {
+	c := newNodesTestClient(config.WithSessionsPerNodeLimit(2))
+	nodeID, ok := c.internalPoolPlacement()
+	require.True(t, ok)
+	require.Equal(t, uint32(0), nodeID)
+
+	c.nodes = map[uint32]struct{}{1: {}, 2: {}, 3: {}}
+	addSessionsOnNode(c, 1, 1)
+	addSessionsOnNode(c, 2, 2)
+	nodeID, ok = c.internalPoolPlacement()
+	require.True(t, ok)
+	require.Equal(t, uint32(3), nodeID)
+
+	c.createInProgressOnNode[3] = 2
+	nodeID, ok = c.internalPoolPlacement()
+	require.True(t, ok)
+	require.Equal(t, uint32(1), nodeID)
+
+	addSessionsOnNode(c, 1, 1)
+	_, ok = c.internalPoolPlacement()
+	require.False(t, ok)
+}
+
+func TestRebalanceNodes(t *testing.T) {
+	c := newNodesTestClient()
+	_, _, ok := c.internalPoolRebalanceNodes()
+	require.False(t, ok)
+
+	c.nodes = map[uint32]struct{}{1: {}, 2: {}}
+	addSessionsOnNode(c, 1, 2)
+	addSessionsOnNode(c, 2, 1)
+	_, _, ok = c.internalPoolRebalanceNodes()
+	require.False(t, ok, "balanced enough")
+
+	c.nodes[3] = struct{}{}
+	from, to, ok := c.internalPoolRebalanceNodes()
+	require.True(t, ok)
+	require.Equal(t, uint32(1), from)
+	require.Equal(t, uint32(3), to)
+
+	addSessionsOnNode(c, 4, 1)
+	from, to, ok = c.internalPoolRebalanceNodes()
+	require.True(t, ok)
+	require.Equal(t, uint32(4), from, "sessions on removed nodes rotates first")
+	require.Equal(t, uint32(3), to)
+}
@@ -43,6 +43,8 @@ func doTx(
 		opts.FastBackoff,
 		opts.SlowBackoff,
 		opts.Idempotent,
+		config.RetryBudget(),
+		retryPolicy(config, opts),
 		func(ctx context.Context, s table.Session) (err error) {
 			attempts++
 
@@ -111,6 +113,8 @@ func do(
 		opts.FastBackoff,
 		opts.SlowBackoff,
 		opts.Idempotent,
+		config.RetryBudget(),
+		retryPolicy(config, opts),
 		func(ctx context.Context, s table.Session) (err error) {
 			attempts++
 
@@ -144,6 +148,8 @@ func retryBackoff(
 	fastBackoff backoff.Backoff,
 	slowBackoff backoff.Backoff,
 	isOperationIdempotent bool,
+	budget *retry.Budget,
+	policy retry.Policy,
 	op table.Operation,
 ) (err error) {
 	err = retry.Retry(
@@ -171,6 +177,8 @@ func retryBackoff(
 		retry.WithFastBackoff(fastBackoff),
 		retry.WithSlowBackoff(slowBackoff),
 		retry.WithIdempotent(isOperationIdempotent),
+		retry.WithBudget(budget),
+		retry.WithPolicy(policy),
 	)
 	if err != nil {
 		return xerrors.WithStackTrace(err)
@@ -178,11 +186,53 @@ func retryBackoff(
 	return nil
 }
 
+// retryPolicy returns retry policy of call: policy from options of call, policy of table client
+// (with backoffs from options of call, if defined) or nil (default policy with backoffs from options of call)
+func retryPolicy(config config.Config, opts table.Options) retry.Policy {
+	if opts.RetryPolicy != nil {
+		return opts.RetryPolicy
+	}
+	p := config.RetryPolicy()
+	if p == nil || (opts.FastBackoff == nil && opts.SlowBackoff == nil) {
+		return p
+	}
+	return callBackoffPolicy{
+		Policy: p,
+		fast:   opts.FastBackoff,
+		slow:   opts.SlowBackoff,
+	}
+}
+
+// callBackoffPolicy replaces fast and slow backoffs of table client retry policy
+// with backoffs from options of call
+type callBackoffPolicy struct {
+	retry.Policy
+	fast backoff.Backoff
+	slow backoff.Backoff
+}
+
+func (p callBackoffPolicy) Backoff(err error) retry.BackoffStrategy {
+	b := p.Policy.Backoff(err)
+	if b == nil {
+		return nil
+	}
+	switch retry.Check(err).BackoffType() {
+	case backoff.TypeFast:
+		if p.fast != nil {
+			return p.fast
+		}
+	case backoff.TypeSlow:
+		if p.slow != nil {
+			return p.slow
+		}
+	}
+	return b
+}
+
+// retryOptions applies options of call. Nil backoffs mean default backoffs of retry policy
 func retryOptions(trace trace.Table, opts ...table.Option) table.Options {
 	options := table.Options{
-		Trace:       trace,
-		FastBackoff: backoff.Fast,
-		SlowBackoff: backoff.Slow,
+		Trace: trace,
 		TxSettings: table.TxSettings(
 			table.WithSerializableReadWrite(),
 		),
@@ -224,6 +224,79 @@ func TestRetryerImmediateReturn(t *testing.T) {
 	}
 }
 
+func TestRetryPolicy(t *testing.T) {
+	for _, tt := range []struct {
+		config   config.Config
+		opts     table.Options
+		attempts int
+	}{
+		{
+			config:   config.New(config.WithRetryPolicy(retry.NewPolicy(retry.WithMaxAttempts(2)))),
+			attempts: 2,
+		},
+		{
+			config: config.New(config.WithRetryPolicy(retry.NewPolicy(retry.WithMaxAttempts(2)))),
+			opts: table.Options{
+				RetryPolicy: retry.NewPolicy(retry.WithMaxAttempts(3)),
+			},
+			attempts: 3,
+		},
+	} {
+		t.Run("", func(t *testing.T) {
+			attempts := 0
+			err := do(
+				context.Background(),
+				SingleSession(simpleSession(t)),
+				tt.config,
+				func(ctx context.Context, _ table.Session) error {
+					attempts++
+					return xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_ABORTED))
+				},
+				tt.opts,
+			)
+			if !xerrors.Is(err, retry.ErrMaxAttempts) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if attempts != tt.attempts {
+				t.Fatalf("unexpected attempts: %d, want: %d", attempts, tt.attempts)
+			}
+		})
+	}
+}
+
+func TestRetryCallBackoffWithClientPolicy(t *testing.T) {
+	var (
+		waits int
+		opts  table.Options
+	)
+	table.WithSlowBackoff(testutil.BackoffFunc(func(n int) <-chan time.Time {
+		waits++
+		ch := make(chan time.Time, 1)
+		ch <- time.Now()
+		return ch
+	}))(&opts)
+	attempts := 0
+	err := do(
+		context.Background(),
+		SingleSession(simpleSession(t)),
+		config.New(config.WithRetryPolicy(retry.NewPolicy(retry.WithMaxAttempts(3)))),
+		func(ctx context.Context, _ table.Session) error {
+			attempts++
+			return xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_OVERLOADED))
+		},
+		opts,
+	)
+	if !xerrors.Is(err, retry.ErrMaxAttempts) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if attempts != 3 {
+		t.Fatalf("unexpected attempts: %d", attempts)
+	}
+	if waits != 2 {
+		t.Fatalf("slow backoff of call not used with retry policy of table client: %d waits", waits)
+	}
+}
+
 // We are testing all suspentions of custom operation func against to all deadline
 // timeouts - all sub-tests must have latency less than timeouts (+tolerance)
 func TestRetryContextDeadline(t *testing.T) {
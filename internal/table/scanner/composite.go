@@ -0,0 +1,190 @@
+package scanner
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+var (
+	typeOfAny      = reflect.TypeOf((*interface{})(nil)).Elem()
+	typeOfBytes    = reflect.TypeOf([]byte(nil))
+	typeOfString   = reflect.TypeOf("")
+	typeOfTime     = reflect.TypeOf(time.Time{})
+	typeOfDuration = reflect.TypeOf(time.Duration(0))
+	typeOfDecimal  = reflect.TypeOf(types.Decimal{})
+	typeOfTuple    = reflect.TypeOf([]interface{}(nil))
+	typeOfStruct   = reflect.TypeOf(map[string]interface{}(nil))
+
+	primitiveGoTypes = map[value.PrimitiveType]reflect.Type{
+		value.TypeBool:         reflect.TypeOf(false),
+		value.TypeInt8:         reflect.TypeOf(int8(0)),
+		value.TypeUint8:        reflect.TypeOf(uint8(0)),
+		value.TypeInt16:        reflect.TypeOf(int16(0)),
+		value.TypeUint16:       reflect.TypeOf(uint16(0)),
+		value.TypeInt32:        reflect.TypeOf(int32(0)),
+		value.TypeUint32:       reflect.TypeOf(uint32(0)),
+		value.TypeInt64:        reflect.TypeOf(int64(0)),
+		value.TypeUint64:       reflect.TypeOf(uint64(0)),
+		value.TypeFloat:        reflect.TypeOf(float32(0)),
+		value.TypeDouble:       reflect.TypeOf(float64(0)),
+		value.TypeUUID:         reflect.TypeOf([16]byte{}),
+		value.TypeString:       typeOfBytes,
+		value.TypeYSON:         typeOfBytes,
+		value.TypeJSON:         typeOfBytes,
+		value.TypeJSONDocument: typeOfBytes,
+		value.TypeUTF8:         typeOfString,
+		value.TypeDyNumber:     typeOfString,
+		value.TypeDate:         typeOfTime,
+		value.TypeDatetime:     typeOfTime,
+		value.TypeTimestamp:    typeOfTime,
+		value.TypeDate32:       typeOfTime,
+		value.TypeDatetime64:   typeOfTime,
+		value.TypeTimestamp64:  typeOfTime,
+		value.TypeTzDate:       typeOfTime,
+		value.TypeTzDatetime:   typeOfTime,
+		value.TypeTzTimestamp:  typeOfTime,
+		value.TypeInterval:     typeOfDuration,
+		value.TypeInterval64:   typeOfDuration,
+	}
+)
+
+// goType returns go type of values which any returns for YDB type t:
+//
+//   - List<T> - slice of go type of T
+//   - Tuple<...> - []interface{}
+//   - Struct<...> - map[string]interface{}
+//   - Dict<K,V> - map of go types of K and V (String keys converts to string)
+//   - Optional<T> in list or dict - pointer to go type of T
+//
+// Other non-primitive types (such as Variant) maps to interface{}
+func goType(t *Ydb.Type) (reflect.Type, error) {
+	t = value.UntagYDB(t)
+	if _, ok := value.PgTypeFromYDB(t); ok {
+		return typeOfString, nil
+	}
+	switch tt := t.Type.(type) {
+	case *Ydb.Type_OptionalType:
+		item, err := goType(tt.OptionalType.Item)
+		if err != nil || item == typeOfAny {
+			return item, err
+		}
+		return reflect.PtrTo(item), nil
+	case *Ydb.Type_ListType:
+		item, err := goType(tt.ListType.Item)
+		if err != nil {
+			return nil, err
+		}
+		return reflect.SliceOf(item), nil
+	case *Ydb.Type_TupleType:
+		return typeOfTuple, nil
+	case *Ydb.Type_StructType:
+		return typeOfStruct, nil
+	case *Ydb.Type_DictType:
+		k, err := goType(tt.DictType.Key)
+		if err != nil {
+			return nil, err
+		}
+		if k == typeOfBytes {
+			k = typeOfString
+		}
+		if !k.Comparable() {
+			return nil, fmt.Errorf("unsupported type of dict key: %s", value.TypeFromYDB(tt.DictType.Key))
+		}
+		v, err := goType(tt.DictType.Payload)
+		if err != nil {
+			return nil, err
+		}
+		return reflect.MapOf(k, v), nil
+	case *Ydb.Type_DecimalType:
+		return typeOfDecimal, nil
+	case *Ydb.Type_TypeId:
+		if p, ok := value.TypeFromYDB(t).(value.PrimitiveType); ok {
+			if goType, has := primitiveGoTypes[p]; has {
+				return goType, nil
+			}
+		}
+	}
+	return typeOfAny, nil
+}
+
+// anyComposite returns value of current item with list, tuple, struct or dict type
+// as go slice or map (see goType). Returns nil for other types
+func (s *scanner) anyComposite() interface{} {
+	x := s.stack.current()
+	typ, err := goType(x.t)
+	if err != nil {
+		_ = s.errorf(1, "scanner.any(): %w", err)
+		return nil
+	}
+	s.stack.enter()
+	defer s.stack.leave()
+	switch t := x.t.Type.(type) {
+	case *Ydb.Type_ListType:
+		v := reflect.MakeSlice(typ, len(x.v.Items), len(x.v.Items))
+		for i := range x.v.Items {
+			s.anyTo(v.Index(i), item{i: i, t: t.ListType.Item, v: x.v.Items[i]})
+		}
+		return v.Interface()
+	case *Ydb.Type_TupleType:
+		v := make([]interface{}, len(x.v.Items))
+		for i := range x.v.Items {
+			s.anyTo(reflect.ValueOf(v).Index(i), item{i: i, t: t.TupleType.Elements[i], v: x.v.Items[i]})
+		}
+		return v
+	case *Ydb.Type_StructType:
+		v := make(map[string]interface{}, len(t.StructType.Members))
+		for i, m := range t.StructType.Members {
+			f := reflect.New(typeOfAny).Elem()
+			s.anyTo(f, item{name: m.Name, i: i, t: m.Type, v: x.v.Items[i]})
+			v[m.Name] = f.Interface()
+		}
+		return v
+	case *Ydb.Type_DictType:
+		v := reflect.MakeMapWithSize(typ, len(x.v.Pairs))
+		for i, p := range x.v.Pairs {
+			key := reflect.New(typ.Key()).Elem()
+			s.anyTo(key, item{i: i, t: t.DictType.Key, v: p.Key})
+			payload := reflect.New(typ.Elem()).Elem()
+			s.anyTo(payload, item{i: i, t: t.DictType.Payload, v: p.Payload})
+			v.SetMapIndex(key, payload)
+		}
+		return v.Interface()
+	default:
+		return nil
+	}
+}
+
+// anyTo sets dst of go type of item x to value of item.
+// NULL values leaves dst with zero value (nil pointer for optional items)
+func (s *scanner) anyTo(dst reflect.Value, x item) {
+	s.stack.scanItem = x.untag()
+	src := s.any()
+	if src == nil || s.Err() != nil {
+		return
+	}
+	v := reflect.ValueOf(src)
+	if dst.Kind() == reflect.Ptr && v.Type() != dst.Type() {
+		p := reflect.New(dst.Type().Elem())
+		s.setConverted(p.Elem(), v)
+		dst.Set(p)
+		return
+	}
+	s.setConverted(dst, v)
+}
+
+func (s *scanner) setConverted(dst, v reflect.Value) {
+	switch {
+	case v.Type().AssignableTo(dst.Type()):
+		dst.Set(v)
+	case v.Type().ConvertibleTo(dst.Type()):
+		dst.Set(v.Convert(dst.Type()))
+	default:
+		_ = s.errorf(2, "scanner.any(): cannot set %s to %s", v.Type(), dst.Type())
+	}
+}
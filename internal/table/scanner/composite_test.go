@@ -0,0 +1,115 @@
+package scanner
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+type anyScanner struct {
+	v interface{}
+}
+
+func (s *anyScanner) UnmarshalYDB(raw types.RawValue) error {
+	s.v = raw.Any()
+	return nil
+}
+
+func TestScanComposite(t *testing.T) {
+	one, two := int64(1), int64(2)
+	for _, tt := range []struct {
+		name string
+		v    types.Value
+		exp  interface{}
+	}{
+		{
+			name: "list",
+			v:    types.ListValue(types.Int64Value(1), types.Int64Value(2)),
+			exp:  []int64{1, 2},
+		},
+		{
+			name: "empty list",
+			v:    types.ZeroValue(types.List(types.TypeUTF8)),
+			exp:  []string{},
+		},
+		{
+			name: "list of optionals",
+			v: types.ListValue(
+				types.OptionalValue(types.Int64Value(1)),
+				types.NullValue(types.TypeInt64),
+				types.OptionalValue(types.Int64Value(2)),
+			),
+			exp: []*int64{&one, nil, &two},
+		},
+		{
+			name: "optional list",
+			v:    types.OptionalValue(types.ListValue(types.TextValue("a"))),
+			exp:  []string{"a"},
+		},
+		{
+			name: "null list",
+			v:    types.NullValue(types.List(types.TypeUTF8)),
+			exp:  nil,
+		},
+		{
+			name: "list of lists",
+			v: types.ListValue(
+				types.ListValue(types.Uint8Value(1)),
+				types.ListValue(types.Uint8Value(2), types.Uint8Value(3)),
+			),
+			exp: [][]uint8{{1}, {2, 3}},
+		},
+		{
+			name: "tuple",
+			v:    types.TupleValue(types.Int32Value(1), types.TextValue("a"), types.NullValue(types.TypeBool)),
+			exp:  []interface{}{int32(1), "a", nil},
+		},
+		{
+			name: "struct",
+			v: types.StructValue(
+				types.StructFieldValue("id", types.Uint64Value(1)),
+				types.StructFieldValue("tags", types.ListValue(types.TextValue("a"))),
+			),
+			exp: map[string]interface{}{"id": uint64(1), "tags": []string{"a"}},
+		},
+		{
+			name: "dict",
+			v: types.DictValue(
+				types.DictFieldValue(types.TextValue("a"), types.Int32Value(1)),
+				types.DictFieldValue(types.TextValue("b"), types.Int32Value(2)),
+			),
+			exp: map[string]int32{"a": 1, "b": 2},
+		},
+		{
+			name: "dict with bytes keys",
+			v:    types.DictValue(types.DictFieldValue(types.BytesValue([]byte("a")), types.DoubleValue(1))),
+			exp:  map[string]float64{"a": 1},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			a := allocator.New()
+			defer a.Free()
+			v := value.ToYDB(tt.v, a)
+			res := NewUnary([]*Ydb.ResultSet{{
+				Columns: []*Ydb.Column{{Name: "v", Type: v.Type}},
+				Rows:    []*Ydb.Value{{Items: []*Ydb.Value{v.Value}}},
+			}}, nil)
+			require.True(t, res.NextResultSet(context.Background()))
+			require.True(t, res.NextRow())
+			var act anyScanner
+			require.NoError(t, res.Scan(&act))
+			require.Equal(t, tt.exp, act.v)
+		})
+	}
+}
+
+func TestGoTypeOfTupleDictKey(t *testing.T) {
+	_, err := goType(value.TypeToYDB(types.Dict(types.Tuple(types.TypeInt32), types.TypeInt32), allocator.New()))
+	require.Error(t, err)
+}
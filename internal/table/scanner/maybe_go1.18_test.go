@@ -0,0 +1,81 @@
+//go:build go1.18
+// +build go1.18
+
+package scanner
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+func TestResultMaybe(t *testing.T) {
+	a := allocator.New()
+	defer a.Free()
+	res := NewUnary(
+		[]*Ydb.ResultSet{
+			NewResultSet(a,
+				WithColumns(
+					options.Column{
+						Name: "column0",
+						Type: types.Optional(types.Optional(types.TypeInt32)),
+					},
+					options.Column{
+						Name: "column1",
+						Type: types.TypeUTF8,
+					},
+				),
+				WithValues(
+					types.OptionalValue(types.OptionalValue(types.Int32Value(42))),
+					types.UTF8Value("a"),
+					types.OptionalValue(types.NullValue(types.TypeInt32)),
+					types.UTF8Value("b"),
+					types.NullValue(types.Optional(types.TypeInt32)),
+					types.UTF8Value("c"),
+				),
+			),
+		},
+		nil,
+	)
+	if !res.NextResultSet(context.Background()) {
+		t.Fatal("unexpected end of result")
+	}
+	for _, exp := range []struct {
+		v    types.Maybe[types.Maybe[int64]]
+		text types.Maybe[string]
+	}{
+		{
+			v:    types.Maybe[types.Maybe[int64]]{Value: types.Maybe[int64]{Value: 42, Valid: true}, Valid: true},
+			text: types.Maybe[string]{Value: "a", Valid: true},
+		},
+		{
+			v:    types.Maybe[types.Maybe[int64]]{Valid: true},
+			text: types.Maybe[string]{Value: "b", Valid: true},
+		},
+		{
+			text: types.Maybe[string]{Value: "c", Valid: true},
+		},
+	} {
+		if !res.NextRow() {
+			t.Fatal("unexpected end of result")
+		}
+		var (
+			v    types.Maybe[types.Maybe[int64]]
+			text types.Maybe[string]
+		)
+		if err := res.Scan(&v, &text); err != nil {
+			t.Fatal(err)
+		}
+		if v != exp.v {
+			t.Errorf("unexpected value: %+v; want %+v", v, exp.v)
+		}
+		if text != exp.text {
+			t.Errorf("unexpected text: %+v; want %+v", text, exp.text)
+		}
+	}
+}
@@ -5,12 +5,14 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value/allocator"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result/named"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
 )
 
@@ -38,6 +40,106 @@ func TestResultAny(t *testing.T) {
 				nil,
 			},
 		},
+		{
+			name: "decimal",
+			columns: []options.Column{
+				{
+					Name:   "column0",
+					Type:   types.Optional(types.DefaultDecimal),
+					Family: "family0",
+				},
+			},
+			values: []types.Value{
+				types.OptionalValue(types.DecimalValue(&types.Decimal{Bytes: [16]byte{15: 1}, Precision: 22, Scale: 9})),
+				types.NullValue(types.DefaultDecimal),
+			},
+			exp: []interface{}{
+				types.Decimal{Bytes: [16]byte{15: 1}, Precision: 22, Scale: 9},
+				nil,
+			},
+		},
+		{
+			name: "date32",
+			columns: []options.Column{
+				{
+					Name:   "column0",
+					Type:   types.TypeDate32,
+					Family: "family0",
+				},
+			},
+			values: []types.Value{
+				types.Date32Value(-1),
+			},
+			exp: []interface{}{
+				time.Unix(-24*60*60, 0),
+			},
+		},
+		{
+			name: "datetime64",
+			columns: []options.Column{
+				{
+					Name:   "column0",
+					Type:   types.TypeDatetime64,
+					Family: "family0",
+				},
+			},
+			values: []types.Value{
+				types.Datetime64Value(-1),
+			},
+			exp: []interface{}{
+				time.Unix(-1, 0),
+			},
+		},
+		{
+			name: "timestamp64",
+			columns: []options.Column{
+				{
+					Name:   "column0",
+					Type:   types.TypeTimestamp64,
+					Family: "family0",
+				},
+			},
+			values: []types.Value{
+				types.Timestamp64Value(-1),
+			},
+			exp: []interface{}{
+				time.Unix(-1, 999999000),
+			},
+		},
+		{
+			name: "interval64",
+			columns: []options.Column{
+				{
+					Name:   "column0",
+					Type:   types.TypeInterval64,
+					Family: "family0",
+				},
+			},
+			values: []types.Value{
+				types.Interval64Value(-1),
+			},
+			exp: []interface{}{
+				-time.Microsecond,
+			},
+		},
+		{
+			name: "pg",
+			columns: []options.Column{
+				{
+					Name:   "column0",
+					Type:   types.Optional(types.Pg(types.PgInt4OID)),
+					Family: "family0",
+				},
+			},
+			values: []types.Value{
+				types.OptionalValue(types.PgValue(types.PgInt4OID, "42")),
+				types.NullValue(types.Pg(types.PgInt4OID)),
+			},
+			exp: []interface{}{
+				"42",
+				nil,
+			},
+		},
 	} {
 		t.Run(test.name, func(t *testing.T) {
 			a := allocator.New()
@@ -138,6 +240,156 @@ func TestResultOUint32(t *testing.T) {
 	}
 }
 
+func TestResultNestedOptional(t *testing.T) {
+	a := allocator.New()
+	defer a.Free()
+	res := NewUnary(
+		[]*Ydb.ResultSet{
+			NewResultSet(a,
+				WithColumns(options.Column{
+					Name: "column0",
+					Type: types.Optional(types.Optional(types.TypeInt32)),
+				}),
+				WithValues(
+					types.OptionalValue(types.OptionalValue(types.Int32Value(42))),
+					types.OptionalValue(types.NullValue(types.TypeInt32)),
+					types.NullValue(types.Optional(types.TypeInt32)),
+				),
+			),
+		},
+		nil,
+	)
+	if !res.NextResultSet(context.Background()) {
+		t.Fatal("unexpected end of result")
+	}
+	for _, exp := range []struct {
+		ptr   *int32
+		any   interface{}
+		def   int32
+		value types.Value
+	}{
+		{
+			ptr:   func(v int32) *int32 { return &v }(42),
+			any:   int32(42),
+			def:   42,
+			value: types.OptionalValue(types.OptionalValue(types.Int32Value(42))),
+		},
+		{
+			value: types.OptionalValue(types.NullValue(types.TypeInt32)),
+		},
+		{
+			value: types.NullValue(types.Optional(types.TypeInt32)),
+		},
+	} {
+		if !res.NextRow() {
+			t.Fatal("unexpected end of result")
+		}
+		var (
+			ptr   *int32
+			any   interface{}
+			def   int32
+			value types.Value
+		)
+		if err := res.ScanNamed(
+			named.Optional("column0", &ptr),
+		); err != nil {
+			t.Fatal(err)
+		}
+		for _, dst := range []interface{}{&any, &def, &value} {
+			res.(*unaryResult).nextItem = 0
+			var err error
+			if dst == &value {
+				err = res.Scan(dst)
+			} else {
+				err = res.ScanWithDefaults(dst)
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		if !reflect.DeepEqual(ptr, exp.ptr) {
+			t.Errorf("unexpected **int32 result: %v; want %v", ptr, exp.ptr)
+		}
+		if !reflect.DeepEqual(any, exp.any) {
+			t.Errorf("unexpected interface{} result: %v; want %v", any, exp.any)
+		}
+		if def != exp.def {
+			t.Errorf("unexpected int32 with default result: %v; want %v", def, exp.def)
+		}
+		if value.String() != exp.value.String() {
+			t.Errorf("unexpected types.Value result: %s; want %s", value, exp.value)
+		}
+	}
+}
+
+func TestResultTagged(t *testing.T) {
+	a := allocator.New()
+	defer a.Free()
+	res := NewUnary(
+		[]*Ydb.ResultSet{
+			NewResultSet(a,
+				WithColumns(
+					options.Column{
+						Name: "id",
+						Type: types.Tagged("id", types.TypeInt32),
+					},
+					options.Column{
+						Name: "name",
+						Type: types.Optional(types.Tagged("name", types.TypeUTF8)),
+					},
+				),
+				WithValues(
+					types.TaggedValue("id", types.Int32Value(42)),
+					types.OptionalValue(types.TaggedValue("name", types.UTF8Value("test"))),
+				),
+			),
+		},
+		nil,
+	)
+	if !res.NextResultSet(context.Background()) || !res.NextRow() {
+		t.Fatal("unexpected end of result")
+	}
+	var (
+		id    int32
+		name  *string
+		value types.Value
+	)
+	if err := res.Scan(&id, &name); err != nil {
+		t.Fatal(err)
+	}
+	if id != 42 || name == nil || *name != "test" {
+		t.Errorf("unexpected result: %v, %v", id, name)
+	}
+	res.(*unaryResult).nextItem = 0
+	var tags []string
+	if err := res.Scan(
+		&value,
+		scannerFunc(func(raw types.RawValue) error {
+			tags = append(tags, raw.Tag())
+			raw.Unwrap()
+			tags = append(tags, raw.Tag())
+			if v := raw.UTF8(); v != "test" {
+				return fmt.Errorf("unexpected value: %q", v)
+			}
+			return nil
+		}),
+	); err != nil {
+		t.Fatal(err)
+	}
+	if exp := "Tagged<Int32,'id'>(42)"; value.String() != exp {
+		t.Errorf("unexpected types.Value result: %s; want %s", value, exp)
+	}
+	if exp := []string{"", "name"}; !reflect.DeepEqual(tags, exp) {
+		t.Errorf("unexpected tags: %v; want %v", tags, exp)
+	}
+}
+
+type scannerFunc func(raw types.RawValue) error
+
+func (f scannerFunc) UnmarshalYDB(raw types.RawValue) error {
+	return f(raw)
+}
+
 type resultSetDesc Ydb.ResultSet
 
 type ResultSetOption func(*resultSetDesc, *allocator.Allocator)
@@ -176,6 +176,26 @@ func (s *rawConverter) Interval() (v time.Duration) {
 	return timeutil.MicrosecondsToDuration(s.int64())
 }
 
+func (s *rawConverter) Date32() (v time.Time) {
+	s.unwrap()
+	return timeutil.UnmarshalDate32(s.int32())
+}
+
+func (s *rawConverter) Datetime64() (v time.Time) {
+	s.unwrap()
+	return timeutil.UnmarshalDatetime64(s.int64())
+}
+
+func (s *rawConverter) Timestamp64() (v time.Time) {
+	s.unwrap()
+	return timeutil.UnmarshalTimestamp64(s.int64())
+}
+
+func (s *rawConverter) Interval64() (v time.Duration) {
+	s.unwrap()
+	return timeutil.MicrosecondsToDuration(s.int64())
+}
+
 func (s *rawConverter) TzDate() (v time.Time) {
 	s.unwrap()
 	if s.isNull() {
@@ -251,6 +271,22 @@ func (s *rawConverter) DyNumber() (v string) {
 	return s.text()
 }
 
+// Pg returns text representation of PostgreSQL-compatible value
+func (s *rawConverter) Pg() (v string) {
+	if s.Err() != nil {
+		return
+	}
+	s.unwrap()
+	return s.text()
+}
+
+func (s *rawConverter) Tag() (tag string) {
+	if s.Err() != nil {
+		return
+	}
+	return s.tag()
+}
+
 func (s *rawConverter) Any() interface{} {
 	return s.any()
 }
@@ -548,12 +584,6 @@ func isEqualDecimal(d *Ydb.DecimalType, t types.Type) bool {
 	return d.Precision == w.Precision && d.Scale == w.Scale
 }
 
-func (s *rawConverter) isCurrentTypeDecimal() bool {
-	c := s.stack.current()
-	_, ok := c.t.Type.(*Ydb.Type_DecimalType)
-	return ok
-}
-
 func (s *rawConverter) unwrapVariantType(typ *Ydb.Type_VariantType, index uint32) (name string, t *Ydb.Type) {
 	i := int(index)
 	switch x := typ.VariantType.Type.(type) {
@@ -279,9 +279,11 @@ func (s *scanner) seekItemByID(id int) error {
 		return s.noValueError()
 	}
 	col := s.set.Columns[id]
-	s.stack.scanItem.name = col.Name
-	s.stack.scanItem.t = col.Type
-	s.stack.scanItem.v = s.row.Items[id]
+	s.stack.scanItem = item{
+		name: col.Name,
+		t:    col.Type,
+		v:    s.row.Items[id],
+	}.untag()
 	return nil
 }
 
@@ -291,9 +293,11 @@ func (s *scanner) seekItemByName(name string) error {
 	}
 	for i, c := range s.set.Columns {
 		if name == c.Name {
-			s.stack.scanItem.name = c.Name
-			s.stack.scanItem.t = c.Type
-			s.stack.scanItem.v = s.row.Items[i]
+			s.stack.scanItem = item{
+				name: c.Name,
+				t:    c.Type,
+				v:    s.row.Items[i],
+			}.untag()
 			return s.Err()
 		}
 	}
@@ -339,6 +343,9 @@ func (s *scanner) setColumnIndexes(columns []string) {
 //	[]byte
 //	string
 //	[16]byte
+//	types.Decimal
+//
+// Values of List, Tuple, Struct and Dict types returns as go slices and maps (see goType).
 //
 //nolint:gocyclo
 func (s *scanner) any() interface{} {
@@ -347,6 +354,8 @@ func (s *scanner) any() interface{} {
 		return nil
 	}
 
+	s.unwrapNested()
+
 	if s.isNull() {
 		return nil
 	}
@@ -356,10 +365,18 @@ func (s *scanner) any() interface{} {
 		x = s.stack.current()
 	}
 
+	if s.isCurrentTypeDecimal() {
+		return s.unwrapDecimal()
+	}
+
+	if s.isCurrentTypePg() {
+		return s.text()
+	}
+
 	t := value.TypeFromYDB(x.t)
 	p, primitive := t.(value.PrimitiveType)
 	if !primitive {
-		return nil
+		return s.anyComposite()
 	}
 
 	switch p {
@@ -395,8 +412,14 @@ func (s *scanner) any() interface{} {
 		return timeutil.UnmarshalTimestamp(s.uint64())
 	case value.TypeInt64:
 		return s.int64()
-	case value.TypeInterval:
+	case value.TypeInterval, value.TypeInterval64:
 		return timeutil.MicrosecondsToDuration(s.int64())
+	case value.TypeDate32:
+		return timeutil.UnmarshalDate32(s.int32())
+	case value.TypeDatetime64:
+		return timeutil.UnmarshalDatetime64(s.int64())
+	case value.TypeTimestamp64:
+		return timeutil.UnmarshalTimestamp64(s.int64())
 	case value.TypeTzDate:
 		src, err := timeutil.UnmarshalTzDate(s.text())
 		if err != nil {
@@ -431,9 +454,17 @@ func (s *scanner) any() interface{} {
 // Value returns current item under scan as ydb.Value types.
 func (s *scanner) value() types.Value {
 	x := s.stack.current()
+	if x.tagged != nil {
+		return value.FromYDB(x.tagged, x.v)
+	}
 	return value.FromYDB(x.t, x.v)
 }
 
+// tag returns tag of current item under scan or empty string if type of item is not tagged
+func (s *scanner) tag() string {
+	return s.stack.current().tagged.GetTaggedType().GetTag()
+}
+
 func (s *scanner) isCurrentTypeOptional() bool {
 	c := s.stack.current()
 	return isOptional(c.t)
@@ -460,6 +491,20 @@ func (s *scanner) unwrap() {
 		s.stack.scanItem.v = s.unwrapValue()
 	}
 	s.stack.scanItem.t = t.OptionalType.Item
+	s.stack.scanItem.tagged = nil
+	s.stack.scanItem = s.stack.scanItem.untag()
+}
+
+// unwrapNested unwraps nested optional types of current item (such as Optional<Optional<T>>)
+// up to innermost optional type. Stops on NULL value at any level
+func (s *scanner) unwrapNested() {
+	for s.Err() == nil && !s.isNull() {
+		t, _ := s.stack.currentType().(*Ydb.Type_OptionalType)
+		if t == nil || !isOptional(t.OptionalType.Item) {
+			return
+		}
+		s.unwrap()
+	}
 }
 
 func (s *scanner) unwrapValue() (v *Ydb.Value) {
@@ -471,6 +516,17 @@ func (s *scanner) unwrapValue() (v *Ydb.Value) {
 	return x.NestedValue
 }
 
+func (s *scanner) isCurrentTypeDecimal() bool {
+	c := s.stack.current()
+	_, ok := c.t.Type.(*Ydb.Type_DecimalType)
+	return ok
+}
+
+func (s *scanner) isCurrentTypePg() bool {
+	_, ok := value.PgTypeFromYDB(s.stack.current().t)
+	return ok
+}
+
 func (s *scanner) unwrapDecimal() (v types.Decimal) {
 	if s.Err() != nil {
 		return
@@ -647,6 +703,12 @@ func (s *scanner) setTime(dst *time.Time) {
 		*dst = timeutil.UnmarshalDatetime(s.uint32())
 	case Ydb.Type_TIMESTAMP:
 		*dst = timeutil.UnmarshalTimestamp(s.uint64())
+	case value.TypeIDDate32:
+		*dst = timeutil.UnmarshalDate32(s.int32())
+	case value.TypeIDDatetime64:
+		*dst = timeutil.UnmarshalDatetime64(s.int64())
+	case value.TypeIDTimestamp64:
+		*dst = timeutil.UnmarshalTimestamp64(s.int64())
 	case Ydb.Type_TZ_DATE:
 		src, err := timeutil.UnmarshalTzDate(s.text())
 		if err != nil {
@@ -679,6 +741,12 @@ func (s *scanner) setString(dst *string) {
 		*dst = s.text()
 	case Ydb.Type_STRING:
 		*dst = string(s.bytes())
+	case Ydb.Type_PRIMITIVE_TYPE_ID_UNSPECIFIED:
+		if !s.isCurrentTypePg() {
+			_ = s.errorf(0, "scan row failed: incorrect source types %s", t)
+			return
+		}
+		*dst = s.text()
 	default:
 		_ = s.errorf(0, "scan row failed: incorrect source types %s", t)
 	}
@@ -693,6 +761,12 @@ func (s *scanner) setByte(dst *[]byte) {
 		*dst = []byte(s.text())
 	case Ydb.Type_STRING:
 		*dst = s.bytes()
+	case Ydb.Type_PRIMITIVE_TYPE_ID_UNSPECIFIED:
+		if !s.isCurrentTypePg() {
+			_ = s.errorf(0, "scan row failed: incorrect source types %s", t)
+			return
+		}
+		*dst = []byte(s.text())
 	default:
 		_ = s.errorf(0, "scan row failed: incorrect source types %s", t)
 	}
@@ -814,6 +888,13 @@ func (s *scanner) scanRequired(value interface{}) {
 
 //nolint:gocyclo
 func (s *scanner) scanOptional(value interface{}, defaultValueForOptional bool) {
+	switch value.(type) {
+	case *types.Value, types.Scanner:
+		// scans optional value as is
+	default:
+		// NULL on any level of nested optionals scans as NULL
+		s.unwrapNested()
+	}
 	if defaultValueForOptional {
 		if s.isNull() {
 			s.setDefaultValue(value)
@@ -1147,16 +1228,26 @@ func (s *scanner) overflowError(i, n interface{}) error {
 var emptyItem item
 
 type item struct {
-	name string
-	i    int // Index in listing types.
-	t    *Ydb.Type
-	v    *Ydb.Value
+	name   string
+	i      int // Index in listing types.
+	t      *Ydb.Type
+	v      *Ydb.Value
+	tagged *Ydb.Type // Type of item with tags. Nil if type of item is not tagged
 }
 
 func (x item) isEmpty() bool {
 	return x.v == nil
 }
 
+// untag removes tags from type of item and keeps original type for access to tags
+func (x item) untag() item {
+	if _, ok := x.t.GetType().(*Ydb.Type_TaggedType); ok {
+		x.tagged = x.t
+		x.t = value.UntagYDB(x.t)
+	}
+	return x
+}
+
 type scanStack struct {
 	v        []item
 	p        int8
@@ -1196,6 +1287,7 @@ func (s *scanStack) leave() {
 }
 
 func (s *scanStack) set(v item) {
+	v = v.untag()
 	if int(s.p) == len(s.v) {
 		s.v = append(s.v, v)
 	} else {
@@ -1238,6 +1330,6 @@ func isOptional(typ *Ydb.Type) bool {
 	if typ == nil {
 		return false
 	}
-	_, yes := typ.Type.(*Ydb.Type_OptionalType)
+	_, yes := value.UntagYDB(typ).Type.(*Ydb.Type_OptionalType)
 	return yes
 }
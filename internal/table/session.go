@@ -388,11 +388,7 @@ func (s *session) DescribeTable(
 
 	indexes := make([]options.IndexDescription, len(result.Indexes))
 	for i, idx := range result.GetIndexes() {
-		indexes[i] = options.IndexDescription{
-			Name:         idx.GetName(),
-			IndexColumns: idx.GetIndexColumns(),
-			Status:       idx.GetStatus(),
-		}
+		indexes[i] = options.NewIndexDescription(idx)
 	}
 
 	return options.Description{
@@ -710,28 +706,31 @@ func (s *session) executeDataQuery(
 	err error,
 ) {
 	var (
-		a       = allocator.New()
-		result  = &Ydb_Table.ExecuteQueryResult{}
-		request = &Ydb_Table.ExecuteDataQueryRequest{
-			SessionId:  s.id,
-			TxControl:  tx.Desc(),
-			Parameters: params.Params().ToYDB(a),
-			Query:      &query.query,
-			QueryCachePolicy: &Ydb_Table.QueryCachePolicy{
-				KeepInCache: len(params.Params()) > 0,
+		a      = allocator.New()
+		result = &Ydb_Table.ExecuteQueryResult{}
+		desc   = options.ExecuteDataQueryDesc{
+			ExecuteDataQueryRequest: Ydb_Table.ExecuteDataQueryRequest{
+				SessionId:  s.id,
+				TxControl:  tx.Desc(),
+				Parameters: params.Params().ToYDB(a),
+				Query:      &query.query,
+				QueryCachePolicy: &Ydb_Table.QueryCachePolicy{
+					KeepInCache: len(params.Params()) > 0,
+				},
+				OperationParams: operation.Params(
+					ctx,
+					s.config.OperationTimeout(),
+					s.config.OperationCancelAfter(),
+					operation.ModeSync,
+				),
 			},
-			OperationParams: operation.Params(
-				ctx,
-				s.config.OperationTimeout(),
-				s.config.OperationCancelAfter(),
-				operation.ModeSync,
-			),
 		}
+		request = &desc.ExecuteDataQueryRequest
 	)
 	defer a.Free()
 
 	for _, opt := range opts {
-		opt((*options.ExecuteDataQueryDesc)(request))
+		opt(&desc)
 	}
 
 	t := s.trailer()
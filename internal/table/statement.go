@@ -2,13 +2,16 @@ package table
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/result"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
@@ -53,6 +56,9 @@ func (s *statement) execute(
 ) (
 	txr table.Transaction, r result.Result, err error,
 ) {
+	if err = s.checkParams(params, opts...); err != nil {
+		return nil, nil, xerrors.WithStackTrace(err)
+	}
 	_, res, err := s.session.executeDataQuery(
 		ctx,
 		tx,
@@ -66,6 +72,35 @@ func (s *statement) execute(
 	return s.session.executeQueryResult(res)
 }
 
+// checkParams checks query parameters against declared parameters of statement
+// if options.WithAutoDeclare defined. Prepared query text already compiled with
+// own DECLARE section, so auto declare cannot change it
+func (s *statement) checkParams(params *table.QueryParameters, opts ...options.ExecuteDataQueryOption) error {
+	var desc options.ExecuteDataQueryDesc
+	for _, opt := range opts {
+		opt(&desc)
+	}
+	if !desc.AutoDeclare() {
+		return nil
+	}
+	var err error
+	params.Each(func(name string, v types.Value) {
+		if err != nil {
+			return
+		}
+		t, has := s.params[name]
+		switch {
+		case !has:
+			err = fmt.Errorf("%w: parameter %s is not declared", errParamsMismatch, name)
+		case !value.TypesEqual(value.TypeFromYDB(t), v.Type()):
+			err = fmt.Errorf("%w: parameter %s declared as %s, but has type %s",
+				errParamsMismatch, name, value.TypeFromYDB(t), v.Type(),
+			)
+		}
+	})
+	return xerrors.WithStackTrace(err)
+}
+
 func (s *statement) NumInput() int {
 	return len(s.params)
 }
@@ -62,6 +62,43 @@ func MarshalTimestamp(t time.Time) uint64 {
 	return uint64(d)
 }
 
+// UnmarshalDate32 returns time from signed number of days since epoch (dates before 1970 are negative)
+func UnmarshalDate32(n int32) time.Time {
+	return time.Unix(int64(n)*secondsPerDay, 0)
+}
+
+func MarshalDate32(t time.Time) int32 {
+	sec := t.Unix()
+	days := sec / secondsPerDay
+	if sec%secondsPerDay < 0 {
+		days--
+	}
+	return int32(days)
+}
+
+// UnmarshalDatetime64 returns time from signed number of seconds since epoch
+func UnmarshalDatetime64(n int64) time.Time {
+	return time.Unix(n, 0)
+}
+
+func MarshalDatetime64(t time.Time) int64 {
+	return t.Unix()
+}
+
+// UnmarshalTimestamp64 returns time from signed number of microseconds since epoch
+func UnmarshalTimestamp64(n int64) time.Time {
+	sec, usec := n/1e6, n%1e6
+	if usec < 0 {
+		sec--
+		usec += 1e6
+	}
+	return time.Unix(sec, usec*1000)
+}
+
+func MarshalTimestamp64(t time.Time) int64 {
+	return t.Unix()*1e6 + int64(t.Nanosecond()/1000)
+}
+
 func UnmarshalTzDate(s string) (time.Time, error) {
 	return time.Parse(tzLayoutDate, s)
 }
@@ -0,0 +1,646 @@
+package value
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/decimal"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/timeutil"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+const dateLayout = "2006-01-02"
+
+// errOutOfRange returned for Date, Datetime and Timestamp values which cannot be represented by its type
+var errOutOfRange = errors.New("value out of range")
+
+// maxTime is an exclusive upper bound of Date, Datetime and Timestamp values
+var maxTime = time.Date(2106, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// typedJSON is a JSON representation of value which keeps type of value
+//
+//	{"type":"Optional<List<Int32>>","value":[1,2,3]}
+type typedJSON struct {
+	Type  string          `json:"type"`
+	Value json.RawMessage `json:"value"`
+}
+
+// marshalJSON returns JSON representation of value with type of value.
+// Values are encoded as follows:
+//   - numbers and Bool as JSON numbers and booleans, not finite floats as strings "NaN", "+Inf" and "-Inf"
+//   - Utf8, Yson, Json, JsonDocument, DyNumber and Tz* values as JSON strings
+//   - String values as base64 strings
+//   - Date as "2006-01-02", Datetime and Timestamp as RFC3339 strings in UTC
+//   - Interval as Go duration string such as "1h2m3.000004s"
+//   - Date32, Datetime64, Timestamp64 and Interval64 as numbers of days, seconds and microseconds
+//   - PostgreSQL-compatible values as strings with text representation of value
+//   - Decimal as string with exact scale, UUID in canonical text form
+//   - NULL as null, non-NULL optional of optional as array with single item (distinguish Just(NULL) from NULL)
+//   - List and Tuple as arrays, Struct as object, Dict as array of key-value pairs,
+//     Variant as object with single member name (or item index) key
+func marshalJSON(v Value) ([]byte, error) {
+	a := allocator.New()
+	defer a.Free()
+
+	typedValue := ToYDB(v, a)
+
+	raw, err := appendValueJSON(nil, typedValue.Type, typedValue.Value)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	b := append([]byte(`{"type":`), appendJSONString(nil, v.Type().String())...)
+	b = append(b, `,"value":`...)
+	b = append(b, raw...)
+
+	return append(b, '}'), nil
+}
+
+// UnmarshalJSON parses JSON representation of value which made with json.Marshal
+func UnmarshalJSON(data []byte) (Value, error) {
+	var typed typedJSON
+	if err := json.Unmarshal(data, &typed); err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	if typed.Type == "" {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("type of value not defined in %q", data))
+	}
+	t, err := ParseType(typed.Type)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	if len(typed.Value) == 0 {
+		typed.Value = json.RawMessage("null")
+	}
+	return ValueFromJSON(t, typed.Value)
+}
+
+// ValueFromJSON parses JSON representation of value of type t (without type information)
+func ValueFromJSON(t Type, data []byte) (Value, error) {
+	a := allocator.New()
+	defer a.Free()
+
+	typeYDB := t.toYDB(a)
+	v, err := valueFromJSON(typeYDB, data)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	vv, err := fromYDB(typeYDB, v)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	return vv, nil
+}
+
+func appendJSONString(b []byte, s string) []byte {
+	buf := bytes.NewBuffer(b)
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(s)
+	return bytes.TrimSuffix(buf.Bytes(), []byte{'\n'})
+}
+
+func appendJSONFloat(b []byte, f float64, bitSize int) []byte {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return appendJSONString(b, strconv.FormatFloat(f, 'g', -1, bitSize))
+	}
+	return strconv.AppendFloat(b, f, 'g', -1, bitSize)
+}
+
+//nolint:gocyclo
+func appendPrimitiveJSON(b []byte, id Ydb.Type_PrimitiveTypeId, v *Ydb.Value) ([]byte, error) {
+	switch id {
+	case Ydb.Type_BOOL:
+		return strconv.AppendBool(b, v.GetBoolValue()), nil
+	case Ydb.Type_INT8, Ydb.Type_INT16, Ydb.Type_INT32, TypeIDDate32:
+		return strconv.AppendInt(b, int64(v.GetInt32Value()), 10), nil
+	case Ydb.Type_UINT8, Ydb.Type_UINT16, Ydb.Type_UINT32:
+		return strconv.AppendUint(b, uint64(v.GetUint32Value()), 10), nil
+	case Ydb.Type_INT64, TypeIDDatetime64, TypeIDTimestamp64, TypeIDInterval64:
+		return strconv.AppendInt(b, v.GetInt64Value(), 10), nil
+	case Ydb.Type_UINT64:
+		return strconv.AppendUint(b, v.GetUint64Value(), 10), nil
+	case Ydb.Type_FLOAT:
+		return appendJSONFloat(b, float64(v.GetFloatValue()), 32), nil
+	case Ydb.Type_DOUBLE:
+		return appendJSONFloat(b, v.GetDoubleValue(), 64), nil
+	case Ydb.Type_DATE:
+		return appendJSONString(b, timeutil.UnmarshalDate(v.GetUint32Value()).UTC().Format(dateLayout)), nil
+	case Ydb.Type_DATETIME:
+		return appendJSONString(b, timeutil.UnmarshalDatetime(v.GetUint32Value()).UTC().Format(time.RFC3339)), nil
+	case Ydb.Type_TIMESTAMP:
+		return appendJSONString(b, timeutil.UnmarshalTimestamp(v.GetUint64Value()).UTC().Format(time.RFC3339Nano)), nil
+	case Ydb.Type_INTERVAL:
+		return appendJSONString(b, timeutil.MicrosecondsToDuration(v.GetInt64Value()).String()), nil
+	case Ydb.Type_STRING:
+		return appendJSONString(b, base64.StdEncoding.EncodeToString(v.GetBytesValue())), nil
+	case Ydb.Type_UUID:
+		b = append(b, '"')
+		b = AppendUUID(b, v.GetLow_128(), v.GetHigh_128())
+		return append(b, '"'), nil
+	case
+		Ydb.Type_UTF8,
+		Ydb.Type_YSON,
+		Ydb.Type_JSON,
+		Ydb.Type_JSON_DOCUMENT,
+		Ydb.Type_DYNUMBER,
+		Ydb.Type_TZ_DATE,
+		Ydb.Type_TZ_DATETIME,
+		Ydb.Type_TZ_TIMESTAMP:
+		return appendJSONString(b, v.GetTextValue()), nil
+	default:
+		return b, xerrors.WithStackTrace(fmt.Errorf("unsupported primitive type: %v", id))
+	}
+}
+
+//nolint:gocyclo
+func appendValueJSON(b []byte, t *Ydb.Type, v *Ydb.Value) (_ []byte, err error) {
+	if _, ok := PgTypeFromYDB(t); ok {
+		return appendJSONString(b, v.GetTextValue()), nil
+	}
+	switch tt := t.Type.(type) {
+	case *Ydb.Type_TypeId:
+		return appendPrimitiveJSON(b, tt.TypeId, v)
+
+	case *Ydb.Type_DecimalType:
+		x := decimal.FromInt128(BigEndianUint128(v.GetHigh_128(), v.GetLow_128()), tt.DecimalType.Precision, tt.DecimalType.Scale)
+		return appendJSONString(b, decimal.Format(x, tt.DecimalType.Precision, tt.DecimalType.Scale)), nil
+
+	case *Ydb.Type_OptionalType:
+		if _, null := v.Value.(*Ydb.Value_NullFlagValue); null {
+			return append(b, "null"...), nil
+		}
+		if _, optional := UntagYDB(tt.OptionalType.Item).Type.(*Ydb.Type_OptionalType); !optional {
+			return appendValueJSON(b, tt.OptionalType.Item, v)
+		}
+		nested, ok := v.Value.(*Ydb.Value_NestedValue)
+		if !ok {
+			return b, xerrors.WithStackTrace(fmt.Errorf("optional of optional value without nested value"))
+		}
+		b = append(b, '[')
+		if b, err = appendValueJSON(b, tt.OptionalType.Item, nested.NestedValue); err != nil {
+			return b, err
+		}
+		return append(b, ']'), nil
+
+	case *Ydb.Type_ListType:
+		b = append(b, '[')
+		for i, item := range v.Items {
+			if i > 0 {
+				b = append(b, ',')
+			}
+			if b, err = appendValueJSON(b, tt.ListType.Item, item); err != nil {
+				return b, err
+			}
+		}
+		return append(b, ']'), nil
+
+	case *Ydb.Type_TupleType:
+		if len(v.Items) != len(tt.TupleType.Elements) {
+			return b, xerrors.WithStackTrace(fmt.Errorf("tuple items count %d not matches to type %v", len(v.Items), tt))
+		}
+		b = append(b, '[')
+		for i, item := range v.Items {
+			if i > 0 {
+				b = append(b, ',')
+			}
+			if b, err = appendValueJSON(b, tt.TupleType.Elements[i], item); err != nil {
+				return b, err
+			}
+		}
+		return append(b, ']'), nil
+
+	case *Ydb.Type_StructType:
+		if len(v.Items) != len(tt.StructType.Members) {
+			return b, xerrors.WithStackTrace(fmt.Errorf("struct items count %d not matches to type %v", len(v.Items), tt))
+		}
+		b = append(b, '{')
+		for i, item := range v.Items {
+			if i > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSONString(b, tt.StructType.Members[i].Name)
+			b = append(b, ':')
+			if b, err = appendValueJSON(b, tt.StructType.Members[i].Type, item); err != nil {
+				return b, err
+			}
+		}
+		return append(b, '}'), nil
+
+	case *Ydb.Type_DictType:
+		b = append(b, '[')
+		for i, pair := range v.Pairs {
+			if i > 0 {
+				b = append(b, ',')
+			}
+			b = append(b, '[')
+			if b, err = appendValueJSON(b, tt.DictType.Key, pair.Key); err != nil {
+				return b, err
+			}
+			b = append(b, ',')
+			if b, err = appendValueJSON(b, tt.DictType.Payload, pair.Payload); err != nil {
+				return b, err
+			}
+			b = append(b, ']')
+		}
+		return append(b, ']'), nil
+
+	case *Ydb.Type_VariantType:
+		nested, ok := v.Value.(*Ydb.Value_NestedValue)
+		if !ok {
+			return b, xerrors.WithStackTrace(fmt.Errorf("variant value without nested value"))
+		}
+		name, item, err := variantItem(tt.VariantType, v.VariantIndex)
+		if err != nil {
+			return b, xerrors.WithStackTrace(err)
+		}
+		b = append(b, '{')
+		b = appendJSONString(b, name)
+		b = append(b, ':')
+		if b, err = appendValueJSON(b, item, nested.NestedValue); err != nil {
+			return b, err
+		}
+		return append(b, '}'), nil
+
+	case *Ydb.Type_TaggedType:
+		return appendValueJSON(b, tt.TaggedType.Type, v)
+
+	case *Ydb.Type_VoidType, *Ydb.Type_NullType:
+		return append(b, "null"...), nil
+
+	case *Ydb.Type_EmptyListType, *Ydb.Type_EmptyDictType:
+		return append(b, "[]"...), nil
+
+	default:
+		return b, xerrors.WithStackTrace(fmt.Errorf("unsupported type: %T", tt))
+	}
+}
+
+// variantItem returns name (or index for tuple variants) and type of variant item with index i
+func variantItem(t *Ydb.VariantType, i uint32) (name string, _ *Ydb.Type, _ error) {
+	switch items := t.Type.(type) {
+	case *Ydb.VariantType_TupleItems:
+		if int(i) < len(items.TupleItems.Elements) {
+			return strconv.Itoa(int(i)), items.TupleItems.Elements[i], nil
+		}
+	case *Ydb.VariantType_StructItems:
+		if int(i) < len(items.StructItems.Members) {
+			return items.StructItems.Members[i].Name, items.StructItems.Members[i].Type, nil
+		}
+	}
+	return "", nil, fmt.Errorf("variant index %d out of type bounds", i)
+}
+
+func unmarshalJSONString(data []byte) (s string, err error) {
+	if err = json.Unmarshal(data, &s); err != nil {
+		return "", xerrors.WithStackTrace(err)
+	}
+	return s, nil
+}
+
+func floatFromJSON(data []byte, bitSize int) (float64, error) {
+	if len(data) > 0 && data[0] == '"' {
+		s, err := unmarshalJSONString(data)
+		if err != nil {
+			return 0, err
+		}
+		f, err := strconv.ParseFloat(s, bitSize)
+		if err != nil || !(math.IsNaN(f) || math.IsInf(f, 0)) {
+			return 0, xerrors.WithStackTrace(fmt.Errorf("wrong float value %s", data))
+		}
+		return f, nil
+	}
+	var f float64
+	if err := json.Unmarshal(data, &f); err != nil {
+		return 0, xerrors.WithStackTrace(err)
+	}
+	return f, nil
+}
+
+// timeFromJSON parses time of Date, Datetime or Timestamp value and checks that time
+// in range [1970-01-01, 2106-01-01) of these types. Wide type (such as Date32) named in error
+// for times out of range
+func timeFromJSON(data []byte, layout string, wide Type) (time.Time, error) {
+	s, err := unmarshalJSONString(data)
+	if err != nil {
+		return time.Time{}, err
+	}
+	tt, err := time.Parse(layout, s)
+	if err != nil {
+		return time.Time{}, xerrors.WithStackTrace(err)
+	}
+	if tt.Before(time.Unix(0, 0)) || !tt.Before(maxTime) {
+		return time.Time{}, xerrors.WithStackTrace(fmt.Errorf(
+			"%w: %s not in range [1970-01-01, 2106-01-01), use %s type for wider range",
+			errOutOfRange, s, wide,
+		))
+	}
+	return tt, nil
+}
+
+//nolint:gocyclo,funlen
+func primitiveFromJSON(id Ydb.Type_PrimitiveTypeId, data []byte) (*Ydb.Value, error) {
+	var (
+		v   = &Ydb.Value{}
+		err error
+	)
+	switch id {
+	case Ydb.Type_BOOL:
+		var x bool
+		err = json.Unmarshal(data, &x)
+		v.Value = &Ydb.Value_BoolValue{BoolValue: x}
+	case Ydb.Type_INT8:
+		var x int8
+		err = json.Unmarshal(data, &x)
+		v.Value = &Ydb.Value_Int32Value{Int32Value: int32(x)}
+	case Ydb.Type_INT16:
+		var x int16
+		err = json.Unmarshal(data, &x)
+		v.Value = &Ydb.Value_Int32Value{Int32Value: int32(x)}
+	case Ydb.Type_INT32, TypeIDDate32:
+		var x int32
+		err = json.Unmarshal(data, &x)
+		v.Value = &Ydb.Value_Int32Value{Int32Value: x}
+	case Ydb.Type_UINT8:
+		var x uint8
+		err = json.Unmarshal(data, &x)
+		v.Value = &Ydb.Value_Uint32Value{Uint32Value: uint32(x)}
+	case Ydb.Type_UINT16:
+		var x uint16
+		err = json.Unmarshal(data, &x)
+		v.Value = &Ydb.Value_Uint32Value{Uint32Value: uint32(x)}
+	case Ydb.Type_UINT32:
+		var x uint32
+		err = json.Unmarshal(data, &x)
+		v.Value = &Ydb.Value_Uint32Value{Uint32Value: x}
+	case Ydb.Type_INT64, TypeIDDatetime64, TypeIDTimestamp64, TypeIDInterval64:
+		var x int64
+		err = json.Unmarshal(data, &x)
+		v.Value = &Ydb.Value_Int64Value{Int64Value: x}
+	case Ydb.Type_UINT64:
+		var x uint64
+		err = json.Unmarshal(data, &x)
+		v.Value = &Ydb.Value_Uint64Value{Uint64Value: x}
+	case Ydb.Type_FLOAT:
+		var x float64
+		x, err = floatFromJSON(data, 32)
+		v.Value = &Ydb.Value_FloatValue{FloatValue: float32(x)}
+	case Ydb.Type_DOUBLE:
+		var x float64
+		x, err = floatFromJSON(data, 64)
+		v.Value = &Ydb.Value_DoubleValue{DoubleValue: x}
+	case Ydb.Type_DATE:
+		var x time.Time
+		x, err = timeFromJSON(data, dateLayout, TypeDate32)
+		v.Value = &Ydb.Value_Uint32Value{Uint32Value: timeutil.MarshalDate(x)}
+	case Ydb.Type_DATETIME:
+		var x time.Time
+		x, err = timeFromJSON(data, time.RFC3339, TypeDatetime64)
+		v.Value = &Ydb.Value_Uint32Value{Uint32Value: timeutil.MarshalDatetime(x)}
+	case Ydb.Type_TIMESTAMP:
+		var x time.Time
+		x, err = timeFromJSON(data, time.RFC3339Nano, TypeTimestamp64)
+		v.Value = &Ydb.Value_Uint64Value{Uint64Value: timeutil.MarshalTimestamp(x)}
+	case Ydb.Type_INTERVAL:
+		var (
+			s string
+			x time.Duration
+		)
+		if s, err = unmarshalJSONString(data); err == nil {
+			x, err = time.ParseDuration(s)
+		}
+		v.Value = &Ydb.Value_Int64Value{Int64Value: timeutil.DurationToMicroseconds(x)}
+	case Ydb.Type_STRING:
+		var x []byte
+		err = json.Unmarshal(data, &x)
+		v.Value = &Ydb.Value_BytesValue{BytesValue: x}
+	case Ydb.Type_UUID:
+		var (
+			s      string
+			lo, hi uint64
+		)
+		if s, err = unmarshalJSONString(data); err == nil {
+			lo, hi, err = ParseUUID(s)
+		}
+		v.Value = &Ydb.Value_Low_128{Low_128: lo}
+		v.High_128 = hi
+	case
+		Ydb.Type_UTF8,
+		Ydb.Type_YSON,
+		Ydb.Type_JSON,
+		Ydb.Type_JSON_DOCUMENT,
+		Ydb.Type_DYNUMBER,
+		Ydb.Type_TZ_DATE,
+		Ydb.Type_TZ_DATETIME,
+		Ydb.Type_TZ_TIMESTAMP:
+		var x string
+		x, err = unmarshalJSONString(data)
+		v.Value = &Ydb.Value_TextValue{TextValue: x}
+	default:
+		err = fmt.Errorf("unsupported primitive type: %v", id)
+	}
+	if err != nil {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("wrong %v value %s: %w", id, data, err))
+	}
+	return v, nil
+}
+
+func isJSONNull(data []byte) bool {
+	return bytes.Equal(bytes.TrimSpace(data), []byte("null"))
+}
+
+func unmarshalJSONArray(data []byte) (items []json.RawMessage, err error) {
+	if err = json.Unmarshal(data, &items); err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	return items, nil
+}
+
+//nolint:gocyclo,funlen
+func valueFromJSON(t *Ydb.Type, data []byte) (_ *Ydb.Value, err error) {
+	if _, ok := PgTypeFromYDB(t); ok {
+		s, err := unmarshalJSONString(data)
+		if err != nil {
+			return nil, err
+		}
+		return &Ydb.Value{Value: &Ydb.Value_TextValue{TextValue: s}}, nil
+	}
+	switch tt := t.Type.(type) {
+	case *Ydb.Type_TypeId:
+		if isJSONNull(data) {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("unexpected null for non-optional %v value", tt.TypeId))
+		}
+		return primitiveFromJSON(tt.TypeId, data)
+
+	case *Ydb.Type_DecimalType:
+		s, err := unmarshalJSONString(data)
+		if err != nil {
+			return nil, err
+		}
+		x, err := decimal.Parse(s, tt.DecimalType.Precision, tt.DecimalType.Scale)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		p := decimal.BigIntToByte(x, tt.DecimalType.Precision, tt.DecimalType.Scale)
+		hi, lo := bytesToUint128(p)
+		return &Ydb.Value{
+			Value:    &Ydb.Value_Low_128{Low_128: lo},
+			High_128: hi,
+		}, nil
+
+	case *Ydb.Type_OptionalType:
+		if isJSONNull(data) {
+			return &Ydb.Value{Value: &Ydb.Value_NullFlagValue{}}, nil
+		}
+		if _, optional := UntagYDB(tt.OptionalType.Item).Type.(*Ydb.Type_OptionalType); !optional {
+			return valueFromJSON(tt.OptionalType.Item, data)
+		}
+		items, err := unmarshalJSONArray(data)
+		if err != nil {
+			return nil, err
+		}
+		if len(items) != 1 {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("optional of optional must be encoded as array with single item, got %s", data))
+		}
+		nested, err := valueFromJSON(tt.OptionalType.Item, items[0])
+		if err != nil {
+			return nil, err
+		}
+		return &Ydb.Value{Value: &Ydb.Value_NestedValue{NestedValue: nested}}, nil
+
+	case *Ydb.Type_ListType:
+		items, err := unmarshalJSONArray(data)
+		if err != nil {
+			return nil, err
+		}
+		v := &Ydb.Value{Items: make([]*Ydb.Value, len(items))}
+		for i, item := range items {
+			if v.Items[i], err = valueFromJSON(tt.ListType.Item, item); err != nil {
+				return nil, err
+			}
+		}
+		return v, nil
+
+	case *Ydb.Type_TupleType:
+		items, err := unmarshalJSONArray(data)
+		if err != nil {
+			return nil, err
+		}
+		if len(items) != len(tt.TupleType.Elements) {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("tuple items count %d not matches to type", len(items)))
+		}
+		v := &Ydb.Value{Items: make([]*Ydb.Value, len(items))}
+		for i, item := range items {
+			if v.Items[i], err = valueFromJSON(tt.TupleType.Elements[i], item); err != nil {
+				return nil, err
+			}
+		}
+		return v, nil
+
+	case *Ydb.Type_StructType:
+		var members map[string]json.RawMessage
+		if err = json.Unmarshal(data, &members); err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		v := &Ydb.Value{Items: make([]*Ydb.Value, len(tt.StructType.Members))}
+		for i, m := range tt.StructType.Members {
+			item, has := members[m.Name]
+			if !has {
+				return nil, xerrors.WithStackTrace(fmt.Errorf("struct member %q not found in %s", m.Name, data))
+			}
+			if v.Items[i], err = valueFromJSON(m.Type, item); err != nil {
+				return nil, err
+			}
+		}
+		if len(members) != len(tt.StructType.Members) {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("unknown struct members in %s", data))
+		}
+		return v, nil
+
+	case *Ydb.Type_DictType:
+		pairs, err := unmarshalJSONArray(data)
+		if err != nil {
+			return nil, err
+		}
+		v := &Ydb.Value{Pairs: make([]*Ydb.ValuePair, len(pairs))}
+		for i, pair := range pairs {
+			kv, err := unmarshalJSONArray(pair)
+			if err != nil {
+				return nil, err
+			}
+			if len(kv) != 2 {
+				return nil, xerrors.WithStackTrace(fmt.Errorf("dict pair must be encoded as [key,value], got %s", pair))
+			}
+			v.Pairs[i] = &Ydb.ValuePair{}
+			if v.Pairs[i].Key, err = valueFromJSON(tt.DictType.Key, kv[0]); err != nil {
+				return nil, err
+			}
+			if v.Pairs[i].Payload, err = valueFromJSON(tt.DictType.Payload, kv[1]); err != nil {
+				return nil, err
+			}
+		}
+		return v, nil
+
+	case *Ydb.Type_VariantType:
+		var items map[string]json.RawMessage
+		if err = json.Unmarshal(data, &items); err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		if len(items) != 1 {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("variant must be encoded as object with single key, got %s", data))
+		}
+		for i := uint32(0); ; i++ {
+			name, item, err := variantItem(tt.VariantType, i)
+			if err != nil {
+				return nil, xerrors.WithStackTrace(fmt.Errorf("unknown variant item in %s", data))
+			}
+			if raw, has := items[name]; has {
+				nested, err := valueFromJSON(item, raw)
+				if err != nil {
+					return nil, err
+				}
+				return &Ydb.Value{
+					Value:        &Ydb.Value_NestedValue{NestedValue: nested},
+					VariantIndex: i,
+				}, nil
+			}
+		}
+
+	case *Ydb.Type_TaggedType:
+		return valueFromJSON(tt.TaggedType.Type, data)
+
+	case *Ydb.Type_VoidType:
+		if !isJSONNull(data) {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("void value must be encoded as null, got %s", data))
+		}
+		return &Ydb.Value{Value: &Ydb.Value_NullFlagValue{}}, nil
+
+	case *Ydb.Type_EmptyListType:
+		items, err := unmarshalJSONArray(data)
+		if err != nil {
+			return nil, err
+		}
+		if len(items) != 0 {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("empty list must be encoded as [], got %s", data))
+		}
+		return &Ydb.Value{}, nil
+
+	default:
+		return nil, xerrors.WithStackTrace(fmt.Errorf("unsupported type: %T", tt))
+	}
+}
+
+// bytesToUint128 returns high and low halves of big-endian 128 bit value
+func bytesToUint128(p [16]byte) (hi, lo uint64) {
+	return binary.BigEndian.Uint64(p[0:8]), binary.BigEndian.Uint64(p[8:16])
+}
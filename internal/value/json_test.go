@@ -0,0 +1,176 @@
+package value
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value/allocator"
+)
+
+func TestMarshalUnmarshalJSON(t *testing.T) {
+	for _, v := range []Value{
+		BoolValue(true),
+		Int8Value(-1),
+		Int16Value(1),
+		Int32Value(1),
+		Int64Value(-1 << 62),
+		Uint8Value(1),
+		Uint16Value(1),
+		Uint32Value(1),
+		Uint64Value(1 << 63),
+		DateValue(1),
+		DatetimeValue(1),
+		TimestampValue(1),
+		IntervalValue(-1),
+		Date32Value(-1),
+		Datetime64Value(-1),
+		Timestamp64Value(-1),
+		Interval64Value(-1),
+		PgValue(25, "text"),
+		OptionalValue(PgValue(23, "42")),
+		NullValue(Pg(23)),
+		VoidValue(),
+		FloatValue(1.5),
+		DoubleValue(-1.25),
+		StringValue([]byte("test")),
+		DecimalValue([...]byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x30, 0x39}, 22, 9),
+		DecimalValue([...]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xcf, 0xc7}, 22, 9),
+		DyNumberValue("123"),
+		JSONValue(`{"a":1}`),
+		JSONDocumentValue("{}"),
+		TzDateValue("2020-01-01,Europe/Berlin"),
+		UTF8Value("\"привет\"\n"),
+		UUIDValue([...]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 0, 1, 2, 3, 4, 5, 6}),
+		YSONValue("{}"),
+		TupleValue(Int64Value(1), UTF8Value("2")),
+		ListValue(Int64Value(1), Int64Value(2)),
+		ListValue(),
+		ZeroValue(List(TypeInt64)),
+		ZeroValue(Dict(TypeUTF8, TypeInt64)),
+		OptionalValue(IntervalValue(1)),
+		OptionalValue(OptionalValue(IntervalValue(1))),
+		OptionalValue(NullValue(TypeInt32)),
+		OptionalValue(StructValue(StructValueField{"id", Uint64Value(1)})),
+		NullValue(Optional(TypeBool)),
+		OptionalValue(NullValue(TypeBool)),
+		OptionalValue(OptionalValue(NullValue(TypeBool))),
+		TaggedValue("tag", Int32Value(1)),
+		TaggedValue("it's", ListValue(UTF8Value("a"))),
+		OptionalValue(TaggedValue("tag", NullValue(TypeInt32))),
+		StructValue(
+			StructValueField{"series_id", Uint64Value(1)},
+			StructValueField{"remove_date", OptionalValue(DateValue(1))},
+			StructValueField{"title", NullValue(TypeUTF8)},
+		),
+		DictValue(
+			DictValueField{UTF8Value("a"), Uint64Value(1)},
+			DictValueField{UTF8Value("b"), Uint64Value(2)},
+		),
+		VariantValue(Int32Value(42), 1, Tuple(TypeString, TypeInt32)),
+		VariantValue(Int32Value(42), 1, Struct(
+			StructField{Name: "foo", T: TypeString},
+			StructField{Name: "bar", T: TypeInt32},
+		)),
+	} {
+		t.Run(v.String(), func(t *testing.T) {
+			b, err := json.Marshal(v)
+			if err != nil {
+				t.Fatal(err)
+			}
+			vv, err := UnmarshalJSON(b)
+			if err != nil {
+				t.Fatalf("unmarshal %s: %v", b, err)
+			}
+			a := allocator.New()
+			defer a.Free()
+			if !proto.Equal(ToYDB(v, a), ToYDB(vv, a)) {
+				t.Errorf("json round trip failed for %s:\n\n - got:  %v\n\n - want: %v", b, ToYDB(vv, a), ToYDB(v, a))
+			}
+		})
+	}
+}
+
+func TestMarshalJSON(t *testing.T) {
+	for _, tt := range []struct {
+		v   Value
+		exp string
+	}{
+		{
+			v:   OptionalValue(Int32Value(1)),
+			exp: `{"type":"Optional<Int32>","value":1}`,
+		},
+		{
+			v:   OptionalValue(NullValue(TypeInt32)),
+			exp: `{"type":"Optional<Optional<Int32>>","value":[null]}`,
+		},
+		{
+			v:   StringValue([]byte("test")),
+			exp: `{"type":"String","value":"dGVzdA=="}`,
+		},
+		{
+			v:   DateValue(1),
+			exp: `{"type":"Date","value":"1970-01-02"}`,
+		},
+		{
+			v:   DoubleValue(math.Inf(1)),
+			exp: `{"type":"Double","value":"+Inf"}`,
+		},
+		{
+			v: StructValue(
+				StructValueField{"id", Uint64Value(1)},
+				StructValueField{"tags", ListValue(UTF8Value("a"), UTF8Value("b"))},
+			),
+			exp: `{"type":"Struct<id:Uint64,tags:List<Utf8>>","value":{"id":1,"tags":["a","b"]}}`,
+		},
+		{
+			v:   DictValue(DictValueField{Int32Value(1), UTF8Value("a")}),
+			exp: `{"type":"Dict<Int32,Utf8>","value":[[1,"a"]]}`,
+		},
+	} {
+		t.Run(tt.exp, func(t *testing.T) {
+			b, err := tt.v.MarshalJSON()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(b) != tt.exp {
+				t.Errorf("unexpected json:\n\n - got:  %s\n\n - want: %s", b, tt.exp)
+			}
+		})
+	}
+}
+
+func TestUnmarshalJSONErrors(t *testing.T) {
+	for _, data := range []string{
+		`{"type":"Int32","value":"1"}`,
+		`{"type":"Int8","value":1000}`,
+		`{"type":"Unknown","value":1}`,
+		`{"type":"Int32","value":null}`,
+		`{"type":"Struct<id:Int32>","value":{"name":1}}`,
+		`{"type":"Uuid","value":"not-uuid"}`,
+		`{"type":"List<>","value":[]}`,
+		`{"type":"Optional<>","value":null}`,
+		`{"type":"EmptyList","value":[1]}`,
+	} {
+		t.Run(data, func(t *testing.T) {
+			if v, err := UnmarshalJSON([]byte(data)); err == nil {
+				t.Errorf("unexpected success: %v", v)
+			}
+		})
+	}
+	for _, data := range []string{
+		`{"type":"Date","value":"1969-01-01"}`,
+		`{"type":"Date","value":"2106-01-01"}`,
+		`{"type":"Datetime","value":"1969-12-31T23:59:59Z"}`,
+		`{"type":"Timestamp","value":"2200-01-01T00:00:00Z"}`,
+	} {
+		t.Run(data, func(t *testing.T) {
+			if v, err := UnmarshalJSON([]byte(data)); !errors.Is(err, errOutOfRange) {
+				t.Errorf("unexpected result: %v, %v", v, err)
+			}
+		})
+	}
+}
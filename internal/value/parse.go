@@ -0,0 +1,686 @@
+package value
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/decimal"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// ParseType parses YQL type string such as "Optional<List<Struct<id:Uint64,name:Utf8>>>".
+// ParseType accepts output of Type.String() and also YQL shorthands such as "Int32?"
+// and quoted struct member names.
+func ParseType(s string) (Type, error) {
+	p := typeParser{s: s}
+	t, err := p.parseType()
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	p.skipSpaces()
+	if p.pos != len(p.s) {
+		return nil, xerrors.WithStackTrace(p.errorf("unexpected trailing symbols"))
+	}
+	return t, nil
+}
+
+type typeParser struct {
+	s   string
+	pos int
+}
+
+func (p *typeParser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("parse type %q at %d: %s", p.s, p.pos, fmt.Sprintf(format, args...))
+}
+
+func (p *typeParser) skipSpaces() {
+	for p.pos < len(p.s) && strings.IndexByte(" \t\r\n", p.s[p.pos]) >= 0 {
+		p.pos++
+	}
+}
+
+// consume skips spaces and consumes c if next symbol is c
+func (p *typeParser) consume(c byte) bool {
+	p.skipSpaces()
+	if p.pos < len(p.s) && p.s[p.pos] == c {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *typeParser) expect(c byte) error {
+	if !p.consume(c) {
+		return p.errorf("expected '%c'", c)
+	}
+	return nil
+}
+
+func isIdentSymbol(c byte) bool {
+	return c == '_' || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9')
+}
+
+func (p *typeParser) ident() string {
+	p.skipSpaces()
+	start := p.pos
+	for p.pos < len(p.s) && isIdentSymbol(p.s[p.pos]) {
+		p.pos++
+	}
+	return p.s[start:p.pos]
+}
+
+// name parses struct member name which may be quoted with backticks, single or double quotes
+func (p *typeParser) name() (string, error) {
+	p.skipSpaces()
+	if p.pos < len(p.s) && strings.IndexByte("`'\"", p.s[p.pos]) >= 0 {
+		var (
+			quote = p.s[p.pos]
+			name  strings.Builder
+		)
+		for p.pos++; p.pos < len(p.s); p.pos++ {
+			switch c := p.s[p.pos]; {
+			case c == quote:
+				p.pos++
+				return name.String(), nil
+			case c == '\\' && p.pos+1 < len(p.s):
+				p.pos++
+				name.WriteByte(p.s[p.pos])
+			default:
+				name.WriteByte(c)
+			}
+		}
+		return "", p.errorf("unclosed quote")
+	}
+	if name := p.ident(); name != "" {
+		return name, nil
+	}
+	return "", p.errorf("expected name")
+}
+
+func (p *typeParser) number() (uint32, error) {
+	p.skipSpaces()
+	start := p.pos
+	for p.pos < len(p.s) && '0' <= p.s[p.pos] && p.s[p.pos] <= '9' {
+		p.pos++
+	}
+	n, err := strconv.ParseUint(p.s[start:p.pos], 10, 32)
+	if err != nil {
+		return 0, p.errorf("expected number")
+	}
+	return uint32(n), nil
+}
+
+func (p *typeParser) parseType() (t Type, err error) {
+	name := p.ident()
+	if name == "" {
+		return nil, p.errorf("expected type name")
+	}
+	switch strings.ToLower(name) {
+	case "optional":
+		var item Type
+		item, err = p.parseItem()
+		t = Optional(item)
+	case "list":
+		var item Type
+		item, err = p.parseItem()
+		t = List(item)
+	case "emptylist":
+		t = EmptyList()
+	case "dict":
+		t, err = p.parseDict()
+	case "tuple":
+		var items []Type
+		items, err = p.parseTupleItems()
+		t = Tuple(items...)
+	case "struct":
+		var fields []StructField
+		fields, err = p.parseStructFields()
+		t = Struct(fields...)
+	case "variant":
+		t, err = p.parseVariant()
+	case "decimal":
+		t, err = p.parseDecimal()
+	case "pgtype":
+		t, err = p.parsePgType()
+	case "tagged":
+		t, err = p.parseTagged()
+	case "void":
+		t = Void()
+	default:
+		pt, ok := primitiveByName(name)
+		if !ok {
+			return nil, p.errorf("unknown type %q", name)
+		}
+		t = pt
+	}
+	if err != nil {
+		return nil, err
+	}
+	for p.consume('?') {
+		t = Optional(t)
+	}
+	return t, nil
+}
+
+func primitiveByName(name string) (PrimitiveType, bool) {
+	for t, s := range primitiveString {
+		if PrimitiveType(t) != TypeUnknown && strings.EqualFold(s, name) {
+			return PrimitiveType(t), true
+		}
+	}
+	return TypeUnknown, false
+}
+
+// parseItem parses single item type of container type. Empty item type (such as "List<>") is an error,
+// type of empty list is named EmptyList
+func (p *typeParser) parseItem() (Type, error) {
+	if err := p.expect('<'); err != nil {
+		return nil, err
+	}
+	if p.consume('>') {
+		return nil, p.errorf("expected item type")
+	}
+	item, err := p.parseType()
+	if err != nil {
+		return nil, err
+	}
+	if err = p.expect('>'); err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+func (p *typeParser) parseDict() (Type, error) {
+	if err := p.expect('<'); err != nil {
+		return nil, err
+	}
+	k, err := p.parseType()
+	if err != nil {
+		return nil, err
+	}
+	if err = p.expect(','); err != nil {
+		return nil, err
+	}
+	v, err := p.parseType()
+	if err != nil {
+		return nil, err
+	}
+	if err = p.expect('>'); err != nil {
+		return nil, err
+	}
+	return Dict(k, v), nil
+}
+
+func (p *typeParser) parseTupleItems() (items []Type, _ error) {
+	if err := p.expect('<'); err != nil {
+		return nil, err
+	}
+	if p.consume('>') {
+		return items, nil
+	}
+	for {
+		item, err := p.parseType()
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+		if p.consume('>') {
+			return items, nil
+		}
+		if err = p.expect(','); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (p *typeParser) parseStructFields() (fields []StructField, _ error) {
+	if err := p.expect('<'); err != nil {
+		return nil, err
+	}
+	if p.consume('>') {
+		return fields, nil
+	}
+	for {
+		name, err := p.name()
+		if err != nil {
+			return nil, err
+		}
+		if err = p.expect(':'); err != nil {
+			return nil, err
+		}
+		t, err := p.parseType()
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, StructField{Name: name, T: t})
+		if p.consume('>') {
+			return fields, nil
+		}
+		if err = p.expect(','); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (p *typeParser) parseVariant() (Type, error) {
+	if err := p.expect('<'); err != nil {
+		return nil, err
+	}
+	inner, err := p.parseType()
+	if err != nil {
+		return nil, err
+	}
+	switch inner.(type) {
+	case *StructType, *TupleType:
+	default:
+		return nil, p.errorf("variant of %s is not supported", inner)
+	}
+	if err = p.expect('>'); err != nil {
+		return nil, err
+	}
+	return Variant(inner), nil
+}
+
+func (p *typeParser) parseDecimal() (Type, error) {
+	if err := p.expect('('); err != nil {
+		return nil, err
+	}
+	precision, err := p.number()
+	if err != nil {
+		return nil, err
+	}
+	if err = p.expect(','); err != nil {
+		return nil, err
+	}
+	scale, err := p.number()
+	if err != nil {
+		return nil, err
+	}
+	if err = p.expect(')'); err != nil {
+		return nil, err
+	}
+	if precision == 0 || precision > decimal.MaxPrecision || scale > precision {
+		return nil, p.errorf("wrong precision and scale of decimal (%d,%d)", precision, scale)
+	}
+	return Decimal(precision, scale), nil
+}
+
+func (p *typeParser) parseTagged() (Type, error) {
+	if err := p.expect('<'); err != nil {
+		return nil, err
+	}
+	t, err := p.parseType()
+	if err != nil {
+		return nil, err
+	}
+	if err = p.expect(','); err != nil {
+		return nil, err
+	}
+	tag, err := p.name()
+	if err != nil {
+		return nil, err
+	}
+	if err = p.expect('>'); err != nil {
+		return nil, err
+	}
+	return Tagged(tag, t), nil
+}
+
+func (p *typeParser) parsePgType() (Type, error) {
+	if err := p.expect('('); err != nil {
+		return nil, err
+	}
+	oid, err := p.number()
+	if err != nil {
+		return nil, err
+	}
+	if err = p.expect(')'); err != nil {
+		return nil, err
+	}
+	return Pg(oid), nil
+}
+
+// ParseValue parses value of type t from text literal in format of Value.String()
+// such as "List<Int32>((1)(2)(3))". Type prefix of literal is optional ("((1)(2)(3))")
+// and must be equal to t if defined. If t is nil then type of value parses from type prefix
+func ParseValue(text string, t Type) (Value, error) {
+	p := valueParser{typeParser{s: text}}
+	if !strings.HasPrefix(text, "(") {
+		tt, err := p.parseType()
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		switch {
+		case t == nil:
+			t = tt
+		case !TypesEqual(t, tt):
+			return nil, xerrors.WithStackTrace(fmt.Errorf("type of literal %s not equals to %s", tt, t))
+		}
+	} else if t == nil {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("type of literal %q not defined", text))
+	}
+
+	a := allocator.New()
+	defer a.Free()
+
+	typeYDB := t.toYDB(a)
+	v, err := p.value(typeYDB)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	if p.pos != len(p.s) {
+		return nil, xerrors.WithStackTrace(p.errorf("unexpected trailing symbols"))
+	}
+	vv, err := fromYDB(typeYDB, v)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	return vv, nil
+}
+
+// valueParser parses value literals. Unlike types values literals are whitespace sensitive
+type valueParser struct {
+	typeParser
+}
+
+func (p *valueParser) next(c byte) bool {
+	if p.pos < len(p.s) && p.s[p.pos] == c {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *valueParser) expectNext(c byte) error {
+	if !p.next(c) {
+		return p.errorf("expected '%c'", c)
+	}
+	return nil
+}
+
+// token returns raw text until closing parenthesis
+func (p *valueParser) token() string {
+	start := p.pos
+	for p.pos < len(p.s) && p.s[p.pos] != ')' {
+		p.pos++
+	}
+	return p.s[start:p.pos]
+}
+
+// text returns unescaped text until not escaped closing parenthesis
+func (p *valueParser) text() string {
+	var b strings.Builder
+	for p.pos < len(p.s) && p.s[p.pos] != ')' {
+		if p.s[p.pos] == '\\' && p.pos+1 < len(p.s) {
+			p.pos++
+		}
+		b.WriteByte(p.s[p.pos])
+		p.pos++
+	}
+	return b.String()
+}
+
+// null consumes NULL literal
+func (p *valueParser) null() bool {
+	if strings.HasPrefix(p.s[p.pos:], "NULL)") {
+		p.pos += len("NULL")
+		return true
+	}
+	return false
+}
+
+// value parses value in parentheses
+func (p *valueParser) value(t *Ydb.Type) (*Ydb.Value, error) {
+	if err := p.expectNext('('); err != nil {
+		return nil, err
+	}
+	v, err := p.body(t)
+	if err != nil {
+		return nil, err
+	}
+	if err = p.expectNext(')'); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+//nolint:gocyclo,funlen
+func (p *valueParser) body(t *Ydb.Type) (_ *Ydb.Value, err error) {
+	if _, ok := PgTypeFromYDB(t); ok {
+		return &Ydb.Value{Value: &Ydb.Value_TextValue{TextValue: p.text()}}, nil
+	}
+	switch tt := t.Type.(type) {
+	case *Ydb.Type_TypeId:
+		return p.primitive(tt.TypeId)
+
+	case *Ydb.Type_DecimalType:
+		hi, lo, err := p.uint128()
+		if err != nil {
+			return nil, err
+		}
+		return &Ydb.Value{Value: &Ydb.Value_Low_128{Low_128: lo}, High_128: hi}, nil
+
+	case *Ydb.Type_TaggedType:
+		return p.body(tt.TaggedType.Type)
+
+	case *Ydb.Type_OptionalType:
+		if p.null() {
+			return &Ydb.Value{Value: &Ydb.Value_NullFlagValue{}}, nil
+		}
+		if _, optional := UntagYDB(tt.OptionalType.Item).Type.(*Ydb.Type_OptionalType); !optional {
+			return p.body(tt.OptionalType.Item)
+		}
+		v, err := p.value(tt.OptionalType.Item)
+		if err != nil {
+			return nil, err
+		}
+		return &Ydb.Value{Value: &Ydb.Value_NestedValue{NestedValue: v}}, nil
+
+	case *Ydb.Type_ListType:
+		v := &Ydb.Value{}
+		for p.pos < len(p.s) && p.s[p.pos] == '(' {
+			item, err := p.value(tt.ListType.Item)
+			if err != nil {
+				return nil, err
+			}
+			v.Items = append(v.Items, item)
+		}
+		return v, nil
+
+	case *Ydb.Type_TupleType:
+		v := &Ydb.Value{Items: make([]*Ydb.Value, len(tt.TupleType.Elements))}
+		for i, item := range tt.TupleType.Elements {
+			if v.Items[i], err = p.value(item); err != nil {
+				return nil, err
+			}
+		}
+		return v, nil
+
+	case *Ydb.Type_StructType:
+		v := &Ydb.Value{Items: make([]*Ydb.Value, len(tt.StructType.Members))}
+		for i, member := range tt.StructType.Members {
+			if v.Items[i], err = p.value(member.Type); err != nil {
+				return nil, err
+			}
+		}
+		return v, nil
+
+	case *Ydb.Type_DictType:
+		v := &Ydb.Value{}
+		for p.next('(') {
+			pair := &Ydb.ValuePair{}
+			if pair.Key, err = p.value(tt.DictType.Key); err != nil {
+				return nil, err
+			}
+			if pair.Payload, err = p.value(tt.DictType.Payload); err != nil {
+				return nil, err
+			}
+			if err = p.expectNext(')'); err != nil {
+				return nil, err
+			}
+			v.Pairs = append(v.Pairs, pair)
+		}
+		return v, nil
+
+	case *Ydb.Type_VariantType:
+		end := strings.IndexByte(p.s[p.pos:], '=')
+		if end < 0 {
+			return nil, p.errorf("expected '='")
+		}
+		name := p.s[p.pos : p.pos+end]
+		p.pos += end + 1
+		i, item, err := variantItemByName(tt.VariantType, name)
+		if err != nil {
+			return nil, p.errorf("%v", err)
+		}
+		nested, err := p.value(item)
+		if err != nil {
+			return nil, err
+		}
+		return &Ydb.Value{
+			Value:        &Ydb.Value_NestedValue{NestedValue: nested},
+			VariantIndex: i,
+		}, nil
+
+	case *Ydb.Type_VoidType:
+		if !p.null() {
+			return nil, p.errorf("expected NULL")
+		}
+		return &Ydb.Value{Value: &Ydb.Value_NullFlagValue{}}, nil
+
+	case *Ydb.Type_EmptyListType, *Ydb.Type_EmptyDictType:
+		return &Ydb.Value{}, nil
+
+	default:
+		return nil, p.errorf("unsupported type %T", tt)
+	}
+}
+
+func variantItemByName(t *Ydb.VariantType, name string) (uint32, *Ydb.Type, error) {
+	switch items := t.Type.(type) {
+	case *Ydb.VariantType_TupleItems:
+		i, err := strconv.ParseUint(name, 10, 32)
+		if err == nil && int(i) < len(items.TupleItems.Elements) {
+			return uint32(i), items.TupleItems.Elements[i], nil
+		}
+	case *Ydb.VariantType_StructItems:
+		for i, m := range items.StructItems.Members {
+			if m.Name == name {
+				return uint32(i), m.Type, nil
+			}
+		}
+	}
+	return 0, nil, fmt.Errorf("unknown variant item %q", name)
+}
+
+// bytes parses bytes in format of fmt.Sprint([]byte) such as "[1 2 3]"
+func (p *valueParser) bytes() ([]byte, error) {
+	s := p.token()
+	if len(s) < 2 || s[0] != '[' || s[len(s)-1] != ']' {
+		return nil, p.errorf("expected bytes")
+	}
+	fields := strings.Fields(s[1 : len(s)-1])
+	b := make([]byte, len(fields))
+	for i, f := range fields {
+		x, err := strconv.ParseUint(f, 10, 8)
+		if err != nil {
+			return nil, p.errorf("wrong byte %q", f)
+		}
+		b[i] = byte(x)
+	}
+	return b, nil
+}
+
+func (p *valueParser) uint128() (hi, lo uint64, _ error) {
+	b, err := p.bytes()
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(b) != 16 {
+		return 0, 0, p.errorf("expected 16 bytes")
+	}
+	return binary.BigEndian.Uint64(b[0:8]), binary.BigEndian.Uint64(b[8:16]), nil
+}
+
+// bitSize returns bit size of integer types which transferred as 32-bit values
+func bitSize(id Ydb.Type_PrimitiveTypeId) int {
+	switch id {
+	case Ydb.Type_INT8, Ydb.Type_UINT8:
+		return 8
+	case Ydb.Type_INT16, Ydb.Type_UINT16:
+		return 16
+	default:
+		return 32
+	}
+}
+
+//nolint:gocyclo,funlen
+func (p *valueParser) primitive(id Ydb.Type_PrimitiveTypeId) (*Ydb.Value, error) {
+	var (
+		v   = &Ydb.Value{}
+		err error
+	)
+	switch id {
+	case Ydb.Type_BOOL:
+		switch s := p.token(); s {
+		case "true", "false":
+			v.Value = &Ydb.Value_BoolValue{BoolValue: s == "true"}
+		default:
+			err = fmt.Errorf("wrong bool %q", s)
+		}
+	case Ydb.Type_INT8, Ydb.Type_INT16, Ydb.Type_INT32, TypeIDDate32:
+		var x int64
+		x, err = strconv.ParseInt(p.token(), 10, bitSize(id))
+		v.Value = &Ydb.Value_Int32Value{Int32Value: int32(x)}
+	case Ydb.Type_UINT8, Ydb.Type_UINT16, Ydb.Type_UINT32, Ydb.Type_DATE, Ydb.Type_DATETIME:
+		var x uint64
+		x, err = strconv.ParseUint(p.token(), 10, bitSize(id))
+		v.Value = &Ydb.Value_Uint32Value{Uint32Value: uint32(x)}
+	case Ydb.Type_INT64, Ydb.Type_INTERVAL, TypeIDDatetime64, TypeIDTimestamp64, TypeIDInterval64:
+		var x int64
+		x, err = strconv.ParseInt(p.token(), 10, 64)
+		v.Value = &Ydb.Value_Int64Value{Int64Value: x}
+	case Ydb.Type_UINT64, Ydb.Type_TIMESTAMP:
+		var x uint64
+		x, err = strconv.ParseUint(p.token(), 10, 64)
+		v.Value = &Ydb.Value_Uint64Value{Uint64Value: x}
+	case Ydb.Type_FLOAT:
+		var x float64
+		x, err = strconv.ParseFloat(p.token(), 32)
+		v.Value = &Ydb.Value_FloatValue{FloatValue: float32(x)}
+	case Ydb.Type_DOUBLE:
+		var x float64
+		x, err = strconv.ParseFloat(p.token(), 64)
+		v.Value = &Ydb.Value_DoubleValue{DoubleValue: x}
+	case Ydb.Type_STRING:
+		var x []byte
+		x, err = p.bytes()
+		v.Value = &Ydb.Value_BytesValue{BytesValue: x}
+	case Ydb.Type_UUID:
+		var hi, lo uint64
+		hi, lo, err = p.uint128()
+		v.Value = &Ydb.Value_Low_128{Low_128: lo}
+		v.High_128 = hi
+	case
+		Ydb.Type_UTF8,
+		Ydb.Type_YSON,
+		Ydb.Type_JSON,
+		Ydb.Type_JSON_DOCUMENT,
+		Ydb.Type_DYNUMBER,
+		Ydb.Type_TZ_DATE,
+		Ydb.Type_TZ_DATETIME,
+		Ydb.Type_TZ_TIMESTAMP:
+		v.Value = &Ydb.Value_TextValue{TextValue: p.text()}
+	default:
+		err = fmt.Errorf("unsupported primitive type %v", id)
+	}
+	if err != nil {
+		return nil, p.errorf("%v", err)
+	}
+	return v, nil
+}
@@ -0,0 +1,202 @@
+package value
+
+import (
+	"math"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value/allocator"
+)
+
+func TestParseType(t *testing.T) {
+	for _, tt := range []struct {
+		s   string
+		exp Type
+	}{
+		{s: "Int32", exp: TypeInt32},
+		{s: "utf8", exp: TypeUTF8},
+		{s: "Int32?", exp: Optional(TypeInt32)},
+		{s: "Optional<Optional<Int32>>", exp: Optional(Optional(TypeInt32))},
+		{s: "EmptyList", exp: EmptyList()},
+		{s: "List< Uint64 >", exp: List(TypeUint64)},
+		{s: "Dict<Utf8,List<Int64?>>", exp: Dict(TypeUTF8, List(Optional(TypeInt64)))},
+		{s: "Tuple<>", exp: Tuple()},
+		{s: "Tuple<Int32,String>", exp: Tuple(TypeInt32, TypeString)},
+		{s: "Decimal(22,9)", exp: Decimal(22, 9)},
+		{s: "Void", exp: Void()},
+		{s: "Date32", exp: TypeDate32},
+		{s: "Timestamp64?", exp: Optional(TypeTimestamp64)},
+		{s: "PgType(25)", exp: Pg(25)},
+		{s: "Tagged<Int32,'id'>", exp: Tagged("id", TypeInt32)},
+		{s: "Tagged<List<Utf8>,`it\\'s`>?", exp: Optional(Tagged("it's", List(TypeUTF8)))},
+		{s: "List<PgType(23)?>", exp: List(Optional(Pg(23)))},
+		{
+			s: "Struct<`id`:Uint64,`air date`:Date?,'it\\'s':Bool>",
+			exp: Struct(
+				StructField{Name: "id", T: TypeUint64},
+				StructField{Name: "air date", T: Optional(TypeDate)},
+				StructField{Name: "it's", T: TypeBool},
+			),
+		},
+		{
+			s: "Variant<Struct<foo:String,bar:Int32>>",
+			exp: Variant(Struct(
+				StructField{Name: "foo", T: TypeString},
+				StructField{Name: "bar", T: TypeInt32},
+			)),
+		},
+	} {
+		t.Run(tt.s, func(t *testing.T) {
+			v, err := ParseType(tt.s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !TypesEqual(v, tt.exp) {
+				t.Errorf("unexpected type: %s, want: %s", v, tt.exp)
+			}
+			vv, err := ParseType(v.String())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !TypesEqual(v, vv) {
+				t.Errorf("round trip failed: %s, want: %s", vv, v)
+			}
+		})
+	}
+	for _, s := range []string{
+		"",
+		"Unknown",
+		"Optional<>",
+		"List<>",
+		"List<Int32",
+		"Dict<Int32>",
+		"Decimal(22)",
+		"Decimal(40,9)",
+		"Decimal(0,0)",
+		"Decimal(10,20)",
+		"List<Optional<>>",
+		"Variant<Int32>",
+		"Int32 Int32",
+		"Struct<`id:Int32>",
+	} {
+		t.Run(s, func(t *testing.T) {
+			if v, err := ParseType(s); err == nil {
+				t.Errorf("unexpected success: %s", v)
+			}
+		})
+	}
+}
+
+func TestParseValue(t *testing.T) {
+	for _, v := range []Value{
+		BoolValue(true),
+		Int8Value(-128),
+		Int16Value(1),
+		Int32Value(-1),
+		Int64Value(-1 << 62),
+		Uint8Value(255),
+		Uint16Value(1),
+		Uint32Value(1),
+		Uint64Value(1 << 63),
+		DateValue(1),
+		DatetimeValue(1),
+		TimestampValue(1),
+		IntervalValue(-1),
+		Date32Value(-1),
+		Datetime64Value(-1),
+		Timestamp64Value(-1),
+		Interval64Value(-1),
+		PgValue(25, "(text)"),
+		OptionalValue(PgValue(23, "42")),
+		VoidValue(),
+		FloatValue(0.1),
+		DoubleValue(-0.1),
+		DoubleValue(math.Inf(-1)),
+		StringValue([]byte("test")),
+		StringValue(nil),
+		DecimalValue([...]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 0, 1, 2, 3, 4, 5, 6}, 22, 9),
+		DyNumberValue("123"),
+		JSONValue(`{"a":[1,2]}`),
+		JSONDocumentValue("{}"),
+		TzDateValue("2020-01-01,Europe/Berlin"),
+		UTF8Value(""),
+		UTF8Value("NULL"),
+		UTF8Value(`(\)) NULL`),
+		UUIDValue([...]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 0, 1, 2, 3, 4, 5, 6}),
+		YSONValue("{a=1}"),
+		TupleValue(Int64Value(1), UTF8Value("2")),
+		ListValue(Int64Value(1), Int64Value(2)),
+		ZeroValue(List(TypeInt64)),
+		ZeroValue(Dict(TypeUTF8, TypeInt64)),
+		OptionalValue(IntervalValue(1)),
+		OptionalValue(UTF8Value("NULL")),
+		OptionalValue(OptionalValue(IntervalValue(1))),
+		OptionalValue(ZeroValue(List(TypeInt64))),
+		OptionalValue(StructValue(StructValueField{"id", Uint64Value(1)})),
+		NullValue(TypeUTF8),
+		NullValue(Optional(TypeBool)),
+		OptionalValue(NullValue(TypeBool)),
+		OptionalValue(OptionalValue(NullValue(TypeBool))),
+		TaggedValue("tag", Int32Value(1)),
+		TaggedValue("it's", ListValue(UTF8Value("a"))),
+		OptionalValue(TaggedValue("tag", NullValue(TypeInt32))),
+		StructValue(
+			StructValueField{"series_id", Uint64Value(1)},
+			StructValueField{"remove date", OptionalValue(DateValue(1))},
+			StructValueField{"title", NullValue(TypeUTF8)},
+		),
+		DictValue(
+			DictValueField{UTF8Value("a"), ListValue(Uint64Value(1))},
+			DictValueField{UTF8Value("b"), ListValue(Uint64Value(2), Uint64Value(3))},
+		),
+		VariantValue(Int32Value(42), 1, Variant(Tuple(TypeString, TypeInt32))),
+		VariantValue(Int32Value(42), 1, Variant(Struct(
+			StructField{Name: "foo", T: TypeString},
+			StructField{Name: "bar", T: TypeInt32},
+		))),
+	} {
+		t.Run(v.String(), func(t *testing.T) {
+			s := v.String()
+			vv, err := ParseValue(s, v.Type())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if vv.String() != s {
+				t.Errorf("round trip failed:\n\n - got:  %s\n\n - want: %s", vv, s)
+			}
+			a := allocator.New()
+			defer a.Free()
+			if !proto.Equal(ToYDB(v, a), ToYDB(vv, a)) {
+				t.Errorf("values not equal:\n\n - got:  %v\n\n - want: %v", ToYDB(vv, a), ToYDB(v, a))
+			}
+			vvv, err := ParseValue(s, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if vvv.String() != s {
+				t.Errorf("round trip without type failed:\n\n - got:  %s\n\n - want: %s", vvv, s)
+			}
+		})
+	}
+	for _, tt := range []struct {
+		text string
+		t    Type
+	}{
+		{text: "(1)", t: nil},
+		{text: "Int32(1)", t: TypeInt64},
+		{text: "Int8(128)", t: TypeInt8},
+		{text: "Int32(1", t: TypeInt32},
+		{text: "Int32(1)(2)", t: TypeInt32},
+		{text: "Bool(1)", t: TypeBool},
+		{text: "Int32(NULL)", t: TypeInt32},
+		{text: "Uuid([1 2 3])", t: TypeUUID},
+		{text: "Variant<Tuple<Int32>>(1=(1))", t: nil},
+	} {
+		t.Run(tt.text, func(t *testing.T) {
+			if v, err := ParseValue(tt.text, tt.t); err == nil {
+				t.Errorf("unexpected success: %s", v)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package value
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value/allocator"
+)
+
+// PgType is a type of PostgreSQL-compatible values in tables created in PostgreSQL compatibility mode
+type PgType struct {
+	OID uint32
+}
+
+func (v PgType) toString(buffer *bytes.Buffer) {
+	buffer.WriteString("PgType(")
+	buffer.WriteString(strconv.FormatUint(uint64(v.OID), 10))
+	buffer.WriteByte(')')
+}
+
+func (v PgType) String() string {
+	buf := allocator.Buffers.Get()
+	defer allocator.Buffers.Put(buf)
+	v.toString(buf)
+	return buf.String()
+}
+
+func (v PgType) equalsTo(rhs Type) bool {
+	vv, ok := rhs.(PgType)
+	return ok && v.OID == vv.OID
+}
+
+func (v PgType) toYDB(a *allocator.Allocator) *Ydb.Type {
+	t := a.Type()
+
+	t.Type = &Ydb.Type_PgType{
+		PgType: &Ydb.PgType{
+			Oid: v.OID,
+		},
+	}
+
+	return t
+}
+
+// PgTypeFromYDB returns PgType if t is a PostgreSQL-compatible type
+func PgTypeFromYDB(t *Ydb.Type) (_ PgType, ok bool) {
+	pg, ok := t.GetType().(*Ydb.Type_PgType)
+	if !ok {
+		return PgType{}, false
+	}
+	return PgType{OID: pg.PgType.GetOid()}, true
+}
+
+// Pg returns PostgreSQL-compatible type with given OID
+func Pg(oid uint32) PgType {
+	return PgType{OID: oid}
+}
+
+type pgValue struct {
+	t     PgType
+	value string
+}
+
+func (v pgValue) toString(buffer *bytes.Buffer) {
+	a := allocator.New()
+	defer a.Free()
+	v.Type().toString(buffer)
+	valueToString(buffer, v.Type(), v.toYDB(a))
+}
+
+func (v pgValue) String() string {
+	buf := allocator.Buffers.Get()
+	defer allocator.Buffers.Put(buf)
+	v.toString(buf)
+	return buf.String()
+}
+
+func (v pgValue) Type() Type {
+	return v.t
+}
+
+func (v pgValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(v)
+}
+
+func (v pgValue) toYDB(a *allocator.Allocator) *Ydb.Value {
+	vv := a.Text()
+	vv.TextValue = v.value
+
+	vvv := a.Value()
+	vvv.Value = vv
+
+	return vvv
+}
+
+// PgValue makes PostgreSQL-compatible value of type with given OID from text representation of value
+func PgValue(oid uint32, text string) pgValue {
+	return pgValue{
+		t:     PgType{OID: oid},
+		value: text,
+	}
+}
+
+func pgValueFromYDB(t PgType, v *Ydb.Value) (Value, error) {
+	x, ok := v.Value.(*Ydb.Value_TextValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value of %s: %T", t, v.Value)
+	}
+	return PgValue(t.OID, x.TextValue), nil
+}
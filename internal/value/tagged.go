@@ -0,0 +1,150 @@
+package value
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value/allocator"
+)
+
+type taggedType struct {
+	tag       string
+	innerType Type
+}
+
+func (v *taggedType) toString(buffer *bytes.Buffer) {
+	buffer.WriteString("Tagged<")
+	v.innerType.toString(buffer)
+	buffer.WriteString(",'")
+	for i := 0; i < len(v.tag); i++ {
+		if v.tag[i] == '\'' || v.tag[i] == '\\' {
+			buffer.WriteByte('\\')
+		}
+		buffer.WriteByte(v.tag[i])
+	}
+	buffer.WriteString("'>")
+}
+
+func (v *taggedType) String() string {
+	buf := allocator.Buffers.Get()
+	defer allocator.Buffers.Put(buf)
+	v.toString(buf)
+	return buf.String()
+}
+
+func (v *taggedType) equalsTo(rhs Type) bool {
+	vv, ok := rhs.(*taggedType)
+	if !ok {
+		return false
+	}
+	return v.tag == vv.tag && v.innerType.equalsTo(vv.innerType)
+}
+
+func (v *taggedType) toYDB(a *allocator.Allocator) *Ydb.Type {
+	t := a.Type()
+
+	t.Type = &Ydb.Type_TaggedType{
+		TaggedType: &Ydb.TaggedType{
+			Tag:  v.tag,
+			Type: v.innerType.toYDB(a),
+		},
+	}
+
+	return t
+}
+
+// Tagged returns type t marked with tag
+func Tagged(tag string, t Type) *taggedType {
+	return &taggedType{
+		tag:       tag,
+		innerType: t,
+	}
+}
+
+// TaggedInnerType returns tag, inner type and true if t is a tagged type
+func TaggedInnerType(t Type) (tag string, _ Type, ok bool) {
+	if v, ok := t.(*taggedType); ok {
+		return v.tag, v.innerType, true
+	}
+	return "", t, false
+}
+
+// untag removes tags from type t
+func untag(t Type) Type {
+	for {
+		v, ok := t.(*taggedType)
+		if !ok {
+			return t
+		}
+		t = v.innerType
+	}
+}
+
+// UntagYDB removes tags from YDB type t
+func UntagYDB(t *Ydb.Type) *Ydb.Type {
+	for {
+		tt, ok := t.Type.(*Ydb.Type_TaggedType)
+		if !ok {
+			return t
+		}
+		t = tt.TaggedType.Type
+	}
+}
+
+type taggedValue struct {
+	tag   string
+	value Value
+}
+
+func (v *taggedValue) toString(buffer *bytes.Buffer) {
+	a := allocator.New()
+	defer a.Free()
+	v.Type().toString(buffer)
+	valueToString(buffer, v.Type(), v.toYDB(a))
+}
+
+func (v *taggedValue) String() string {
+	buf := allocator.Buffers.Get()
+	defer allocator.Buffers.Put(buf)
+	v.toString(buf)
+	return buf.String()
+}
+
+func (v *taggedValue) Type() Type {
+	return Tagged(v.tag, v.value.Type())
+}
+
+func (v *taggedValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(v)
+}
+
+// toYDB returns inner value as is because tags are not transferred with values
+func (v *taggedValue) toYDB(a *allocator.Allocator) *Ydb.Value {
+	return v.value.toYDB(a)
+}
+
+// TaggedValue makes value of type Tagged<T,tag> where T is a type of v
+func TaggedValue(tag string, v Value) *taggedValue {
+	return &taggedValue{
+		tag:   tag,
+		value: v,
+	}
+}
+
+// TaggedValueInner returns tag, inner value and true if v is a tagged value
+func TaggedValueInner(v Value) (tag string, _ Value, ok bool) {
+	if vv, ok := v.(*taggedValue); ok {
+		return vv.tag, vv.value, true
+	}
+	return "", v, false
+}
+
+func taggedValueFromYDB(t *Ydb.TaggedType, v *Ydb.Value) (Value, error) {
+	vv, err := fromYDB(t.Type, v)
+	if err != nil {
+		return nil, fmt.Errorf("tagged value with tag %q: %w", t.Tag, err)
+	}
+	return TaggedValue(t.Tag, vv), nil
+}
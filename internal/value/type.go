@@ -73,6 +73,15 @@ func TypeFromYDB(x *Ydb.Type) Type {
 	case *Ydb.Type_VoidType:
 		return Void()
 
+	case *Ydb.Type_EmptyListType:
+		return EmptyList()
+
+	case *Ydb.Type_TaggedType:
+		return Tagged(v.TaggedType.Tag, TypeFromYDB(v.TaggedType.Type))
+
+	case *Ydb.Type_PgType:
+		return Pg(v.PgType.GetOid())
+
 	default:
 		panic("ydb: unknown type")
 	}
@@ -130,6 +139,14 @@ func primitiveTypeFromYDB(t Ydb.Type_PrimitiveTypeId) Type {
 		return TypeJSONDocument
 	case Ydb.Type_DYNUMBER:
 		return TypeDyNumber
+	case TypeIDDate32:
+		return TypeDate32
+	case TypeIDDatetime64:
+		return TypeDatetime64
+	case TypeIDTimestamp64:
+		return TypeTimestamp64
+	case TypeIDInterval64:
+		return TypeInterval64
 	default:
 		panic("ydb: unexpected type")
 	}
@@ -249,7 +266,7 @@ func Dict(key, value Type) (v *dictType) {
 type emptyListType struct{}
 
 func (v emptyListType) toString(buffer *bytes.Buffer) {
-	buffer.WriteString("List<>")
+	buffer.WriteString("EmptyList")
 }
 
 func (v emptyListType) String() string {
@@ -367,6 +384,14 @@ func Optional(t Type) *optionalType {
 	}
 }
 
+// OptionalInnerType returns inner type and true if t is an optional type
+func OptionalInnerType(t Type) (Type, bool) {
+	if v, ok := t.(*optionalType); ok {
+		return v.innerType, true
+	}
+	return t, false
+}
+
 type PrimitiveType uint
 
 func (v PrimitiveType) toString(buffer *bytes.Buffer) {
@@ -407,6 +432,19 @@ const (
 	TypeUUID
 	TypeJSONDocument
 	TypeDyNumber
+	TypeDate32
+	TypeDatetime64
+	TypeTimestamp64
+	TypeInterval64
+)
+
+// Identifiers of wide date and time types with dates before 1970 and signed ranges.
+// Generated protos of ydb-go-genproto not contains them yet
+const (
+	TypeIDDate32      = Ydb.Type_PrimitiveTypeId(64)
+	TypeIDDatetime64  = Ydb.Type_PrimitiveTypeId(65)
+	TypeIDTimestamp64 = Ydb.Type_PrimitiveTypeId(66)
+	TypeIDInterval64  = Ydb.Type_PrimitiveTypeId(67)
 )
 
 var primitive = [...]*Ydb.Type{
@@ -435,6 +473,10 @@ var primitive = [...]*Ydb.Type{
 	TypeUUID:         {Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_UUID}},
 	TypeJSONDocument: {Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_JSON_DOCUMENT}},
 	TypeDyNumber:     {Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_DYNUMBER}},
+	TypeDate32:       {Type: &Ydb.Type_TypeId{TypeId: TypeIDDate32}},
+	TypeDatetime64:   {Type: &Ydb.Type_TypeId{TypeId: TypeIDDatetime64}},
+	TypeTimestamp64:  {Type: &Ydb.Type_TypeId{TypeId: TypeIDTimestamp64}},
+	TypeInterval64:   {Type: &Ydb.Type_TypeId{TypeId: TypeIDInterval64}},
 }
 
 var primitiveString = [...]string{
@@ -464,6 +506,10 @@ var primitiveString = [...]string{
 	TypeUUID:         "Uuid",
 	TypeJSONDocument: "JsonDocument",
 	TypeDyNumber:     "DyNumber",
+	TypeDate32:       "Date32",
+	TypeDatetime64:   "Datetime64",
+	TypeTimestamp64:  "Timestamp64",
+	TypeInterval64:   "Interval64",
 }
 
 func (v PrimitiveType) equalsTo(rhs Type) bool {
@@ -498,13 +544,34 @@ func (v *StructType) toString(buffer *bytes.Buffer) {
 		if i > 0 {
 			buffer.WriteByte(',')
 		}
-		buffer.WriteString(f.Name)
+		writeStructFieldName(buffer, f.Name)
 		buffer.WriteByte(':')
 		f.T.toString(buffer)
 	}
 	buffer.WriteByte('>')
 }
 
+// writeStructFieldName writes name of struct member as is or quotes it with backticks
+// if name is not an identifier
+func writeStructFieldName(buffer *bytes.Buffer, name string) {
+	ident := name != ""
+	for i := 0; i < len(name) && ident; i++ {
+		ident = isIdentSymbol(name[i])
+	}
+	if ident {
+		buffer.WriteString(name)
+		return
+	}
+	buffer.WriteByte('`')
+	for i := 0; i < len(name); i++ {
+		if name[i] == '`' || name[i] == '\\' {
+			buffer.WriteByte('\\')
+		}
+		buffer.WriteByte(name[i])
+	}
+	buffer.WriteByte('`')
+}
+
 func (v *StructType) String() string {
 	buf := allocator.Buffers.Get()
 	defer allocator.Buffers.Put(buf)
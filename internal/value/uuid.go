@@ -0,0 +1,49 @@
+package value
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+const hexDigits = "0123456789abcdef"
+
+// uuidBytes returns bytes of UUID in canonical order.
+// YDB stores first three groups of UUID in little-endian order
+func uuidBytes(lo, hi uint64) (p [16]byte) {
+	binary.LittleEndian.PutUint64(p[0:8], lo)
+	binary.LittleEndian.PutUint64(p[8:16], hi)
+	p[0], p[1], p[2], p[3] = p[3], p[2], p[1], p[0]
+	p[4], p[5] = p[5], p[4]
+	p[6], p[7] = p[7], p[6]
+	return p
+}
+
+// AppendUUID appends canonical text form (such as "6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+// of YDB UUID value with low and high halves lo and hi
+func AppendUUID(b []byte, lo, hi uint64) []byte {
+	for i, c := range uuidBytes(lo, hi) {
+		if i == 4 || i == 6 || i == 8 || i == 10 {
+			b = append(b, '-')
+		}
+		b = append(b, hexDigits[c>>4], hexDigits[c&0x0f])
+	}
+	return b
+}
+
+// ParseUUID parses canonical text form of UUID into low and high halves of YDB UUID value
+func ParseUUID(s string) (lo, hi uint64, _ error) {
+	if len(s) != 36 || s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+		return 0, 0, xerrors.WithStackTrace(fmt.Errorf("wrong uuid format: %q", s))
+	}
+	var p [16]byte
+	if _, err := hex.Decode(p[:], []byte(s[0:8]+s[9:13]+s[14:18]+s[19:23]+s[24:36])); err != nil {
+		return 0, 0, xerrors.WithStackTrace(fmt.Errorf("wrong uuid format: %q: %w", s, err))
+	}
+	p[0], p[1], p[2], p[3] = p[3], p[2], p[1], p[0]
+	p[4], p[5] = p[5], p[4]
+	p[6], p[7] = p[7], p[6]
+	return binary.LittleEndian.Uint64(p[0:8]), binary.LittleEndian.Uint64(p[8:16]), nil
+}
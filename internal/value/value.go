@@ -16,6 +16,9 @@ type Value interface {
 	Type() Type
 	String() string
 
+	// MarshalJSON returns JSON representation of value with type of value. See UnmarshalJSON
+	MarshalJSON() ([]byte, error)
+
 	toYDB(a *allocator.Allocator) *Ydb.Value
 	toString(*bytes.Buffer)
 }
@@ -32,6 +35,7 @@ func ToYDB(v Value, a *allocator.Allocator) *Ydb.TypedValue {
 func valueToString(buf *bytes.Buffer, t Type, v *Ydb.Value) {
 	buf.WriteByte('(')
 	defer buf.WriteByte(')')
+	t = untag(t)
 	if x, ok := v.Value.(*Ydb.Value_NestedValue); ok {
 		switch x := t.(type) {
 		case *variantType:
@@ -61,13 +65,24 @@ func valueToString(buf *bytes.Buffer, t Type, v *Ydb.Value) {
 		return
 	}
 	if x, ok := primitiveGoTypeFromYDB(v); ok {
-		if x != nil {
-			fmt.Fprintf(buf, "%v", x)
-		} else {
+		switch x := x.(type) {
+		case nil:
 			buf.WriteString("NULL")
+		case string:
+			writeText(buf, x)
+		default:
+			fmt.Fprintf(buf, "%v", x)
 		}
 		return
 	}
+	// optional of container type holds items or pairs of inner value directly
+	for {
+		x, ok := t.(*optionalType)
+		if !ok {
+			break
+		}
+		t = untag(x.innerType)
+	}
 	if n := len(v.Items); n > 0 {
 		types := make([]Type, n)
 		switch x := t.(type) {
@@ -100,6 +115,20 @@ func valueToString(buf *bytes.Buffer, t Type, v *Ydb.Value) {
 	}
 }
 
+// writeText writes text value with escaping of symbols which makes text literal ambiguous:
+// parentheses, backslash and text "NULL" which not distinguishable from NULL value
+func writeText(buf *bytes.Buffer, s string) {
+	if s == "NULL" {
+		buf.WriteByte('\\')
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] == '(' || s[i] == ')' || s[i] == '\\' {
+			buf.WriteByte('\\')
+		}
+		buf.WriteByte(s[i])
+	}
+}
+
 func primitiveGoTypeFromYDB(x *Ydb.Value) (v interface{}, primitive bool) {
 	switch v := x.Value.(type) {
 	case *Ydb.Value_BoolValue:
@@ -146,23 +175,19 @@ func FromYDB(t *Ydb.Type, v *Ydb.Value) Value {
 	}
 }
 
+// nullValueFromYDB returns NULL value of optional type t or Void value if x is a null flag.
+// Optional<Optional<T>> values with null flag in nested value (such as Just(NULL)) are not NULL
 func nullValueFromYDB(x *Ydb.Value, t Type) (_ Value, ok bool) {
-	for {
-		switch xx := x.Value.(type) {
-		case *Ydb.Value_NestedValue:
-			x = xx.NestedValue
-		case *Ydb.Value_NullFlagValue:
-			switch tt := t.(type) {
-			case *optionalType:
-				return NullValue(tt.innerType), true
-			case voidType:
-				return VoidValue(), true
-			default:
-				return nil, false
-			}
-		default:
-			return nil, false
-		}
+	if _, null := x.Value.(*Ydb.Value_NullFlagValue); !null {
+		return nil, false
+	}
+	switch tt := t.(type) {
+	case *optionalType:
+		return NullValue(tt.innerType), true
+	case voidType:
+		return VoidValue(), true
+	default:
+		return nil, false
 	}
 }
 
@@ -207,6 +232,18 @@ func primitiveValueFromYDB(t PrimitiveType, v *Ydb.Value) (Value, error) {
 	case TypeTimestamp:
 		return TimestampValue(v.GetUint64Value()), nil
 
+	case TypeDate32:
+		return Date32Value(v.GetInt32Value()), nil
+
+	case TypeDatetime64:
+		return Datetime64Value(v.GetInt64Value()), nil
+
+	case TypeTimestamp64:
+		return Timestamp64Value(v.GetInt64Value()), nil
+
+	case TypeInterval64:
+		return Interval64Value(v.GetInt64Value()), nil
+
 	case TypeFloat:
 		return FloatValue(v.GetFloatValue()), nil
 
@@ -265,6 +302,12 @@ func fromYDB(t *Ydb.Type, v *Ydb.Value) (Value, error) {
 	case *DecimalType:
 		return DecimalValue(BigEndianUint128(v.High_128, v.GetLow_128()), ttt.Precision, ttt.Scale), nil
 
+	case PgType:
+		return pgValueFromYDB(ttt, v)
+
+	case *taggedType:
+		return taggedValueFromYDB(t.GetTaggedType(), v)
+
 	case *optionalType:
 		t = t.Type.(*Ydb.Type_OptionalType).OptionalType.Item
 		if nestedValue, ok := v.Value.(*Ydb.Value_NestedValue); ok {
@@ -273,6 +316,9 @@ func fromYDB(t *Ydb.Type, v *Ydb.Value) (Value, error) {
 		return OptionalValue(FromYDB(t, v)), nil
 
 	case *listType:
+		if len(v.Items) == 0 {
+			return ZeroValue(ttt), nil
+		}
 		return ListValue(func() (vv []Value) {
 			a := allocator.New()
 			defer a.Free()
@@ -305,7 +351,13 @@ func fromYDB(t *Ydb.Type, v *Ydb.Value) (Value, error) {
 			return vv
 		}()...), nil
 
+	case emptyListType:
+		return ListValue(), nil
+
 	case *dictType:
+		if len(v.Pairs) == 0 {
+			return ZeroValue(ttt), nil
+		}
 		return DictValue(func() (vv []DictValueField) {
 			a := allocator.New()
 			defer a.Free()
@@ -369,6 +421,10 @@ func (boolValue) Type() Type {
 	return TypeBool
 }
 
+func (v boolValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(v)
+}
+
 func (v boolValue) toYDB(a *allocator.Allocator) *Ydb.Value {
 	vv := a.Bool()
 
@@ -404,6 +460,10 @@ func (dateValue) Type() Type {
 	return TypeDate
 }
 
+func (v dateValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(v)
+}
+
 func (v dateValue) toYDB(a *allocator.Allocator) *Ydb.Value {
 	vv := a.Uint32()
 
@@ -419,6 +479,45 @@ func DateValue(v uint32) dateValue {
 	return dateValue(v)
 }
 
+type date32Value int32
+
+func (v date32Value) toString(buffer *bytes.Buffer) {
+	a := allocator.New()
+	defer a.Free()
+	v.Type().toString(buffer)
+	valueToString(buffer, v.Type(), v.toYDB(a))
+}
+
+func (v date32Value) String() string {
+	buf := allocator.Buffers.Get()
+	defer allocator.Buffers.Put(buf)
+	v.toString(buf)
+	return buf.String()
+}
+
+func (date32Value) Type() Type {
+	return TypeDate32
+}
+
+func (v date32Value) MarshalJSON() ([]byte, error) {
+	return marshalJSON(v)
+}
+
+func (v date32Value) toYDB(a *allocator.Allocator) *Ydb.Value {
+	vv := a.Int32()
+
+	vv.Int32Value = int32(v)
+
+	vvv := a.Value()
+	vvv.Value = vv
+
+	return vvv
+}
+
+func Date32Value(v int32) date32Value {
+	return date32Value(v)
+}
+
 type datetimeValue uint32
 
 func (v datetimeValue) toString(buffer *bytes.Buffer) {
@@ -439,6 +538,10 @@ func (datetimeValue) Type() Type {
 	return TypeDatetime
 }
 
+func (v datetimeValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(v)
+}
+
 func (v datetimeValue) toYDB(a *allocator.Allocator) *Ydb.Value {
 	vv := a.Uint32()
 	vv.Uint32Value = uint32(v)
@@ -453,6 +556,45 @@ func DatetimeValue(v uint32) datetimeValue {
 	return datetimeValue(v)
 }
 
+type datetime64Value int64
+
+func (v datetime64Value) toString(buffer *bytes.Buffer) {
+	a := allocator.New()
+	defer a.Free()
+	v.Type().toString(buffer)
+	valueToString(buffer, v.Type(), v.toYDB(a))
+}
+
+func (v datetime64Value) String() string {
+	buf := allocator.Buffers.Get()
+	defer allocator.Buffers.Put(buf)
+	v.toString(buf)
+	return buf.String()
+}
+
+func (datetime64Value) Type() Type {
+	return TypeDatetime64
+}
+
+func (v datetime64Value) MarshalJSON() ([]byte, error) {
+	return marshalJSON(v)
+}
+
+func (v datetime64Value) toYDB(a *allocator.Allocator) *Ydb.Value {
+	vv := a.Int64()
+
+	vv.Int64Value = int64(v)
+
+	vvv := a.Value()
+	vvv.Value = vv
+
+	return vvv
+}
+
+func Datetime64Value(v int64) datetime64Value {
+	return datetime64Value(v)
+}
+
 type decimalValue struct {
 	value     [16]byte
 	innerType *DecimalType
@@ -476,6 +618,10 @@ func (v decimalValue) Type() Type {
 	return v.innerType
 }
 
+func (v *decimalValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(v)
+}
+
 func (v *decimalValue) toYDB(a *allocator.Allocator) *Ydb.Value {
 	var bytes [16]byte
 	if v != nil {
@@ -530,6 +676,10 @@ func (v *dictValue) Type() Type {
 	return v.t
 }
 
+func (v *dictValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(v)
+}
+
 func (v *dictValue) toYDB(a *allocator.Allocator) *Ydb.Value {
 	var values []DictValueField
 	if v != nil {
@@ -578,6 +728,10 @@ func (*doubleValue) Type() Type {
 	return TypeDouble
 }
 
+func (v *doubleValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(v)
+}
+
 func (v *doubleValue) toYDB(a *allocator.Allocator) *Ydb.Value {
 	vv := a.Double()
 	if v != nil {
@@ -616,6 +770,10 @@ func (dyNumberValue) Type() Type {
 	return TypeDyNumber
 }
 
+func (v *dyNumberValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(v)
+}
+
 func (v *dyNumberValue) toYDB(a *allocator.Allocator) *Ydb.Value {
 	vv := a.Text()
 	if v != nil {
@@ -654,6 +812,10 @@ func (*floatValue) Type() Type {
 	return TypeFloat
 }
 
+func (v *floatValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(v)
+}
+
 func (v *floatValue) toYDB(a *allocator.Allocator) *Ydb.Value {
 	vv := a.Float()
 	if v != nil {
@@ -690,6 +852,10 @@ func (int8Value) Type() Type {
 	return TypeInt8
 }
 
+func (v int8Value) MarshalJSON() ([]byte, error) {
+	return marshalJSON(v)
+}
+
 func (v int8Value) toYDB(a *allocator.Allocator) *Ydb.Value {
 	vv := a.Int32()
 	vv.Int32Value = int32(v)
@@ -724,6 +890,10 @@ func (int16Value) Type() Type {
 	return TypeInt16
 }
 
+func (v int16Value) MarshalJSON() ([]byte, error) {
+	return marshalJSON(v)
+}
+
 func (v int16Value) toYDB(a *allocator.Allocator) *Ydb.Value {
 	vv := a.Int32()
 	vv.Int32Value = int32(v)
@@ -758,6 +928,10 @@ func (int32Value) Type() Type {
 	return TypeInt32
 }
 
+func (v int32Value) MarshalJSON() ([]byte, error) {
+	return marshalJSON(v)
+}
+
 func (v int32Value) toYDB(a *allocator.Allocator) *Ydb.Value {
 	vv := a.Int32()
 	vv.Int32Value = int32(v)
@@ -792,6 +966,10 @@ func (int64Value) Type() Type {
 	return TypeInt64
 }
 
+func (v int64Value) MarshalJSON() ([]byte, error) {
+	return marshalJSON(v)
+}
+
 func (v int64Value) toYDB(a *allocator.Allocator) *Ydb.Value {
 	vv := a.Int64()
 	vv.Int64Value = int64(v)
@@ -826,6 +1004,10 @@ func (intervalValue) Type() Type {
 	return TypeInterval
 }
 
+func (v intervalValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(v)
+}
+
 func (v intervalValue) toYDB(a *allocator.Allocator) *Ydb.Value {
 	vv := a.Int64()
 	vv.Int64Value = int64(v)
@@ -841,6 +1023,45 @@ func IntervalValue(v int64) intervalValue {
 	return intervalValue(v)
 }
 
+type interval64Value int64
+
+func (v interval64Value) toString(buffer *bytes.Buffer) {
+	a := allocator.New()
+	defer a.Free()
+	v.Type().toString(buffer)
+	valueToString(buffer, v.Type(), v.toYDB(a))
+}
+
+func (v interval64Value) String() string {
+	buf := allocator.Buffers.Get()
+	defer allocator.Buffers.Put(buf)
+	v.toString(buf)
+	return buf.String()
+}
+
+func (interval64Value) Type() Type {
+	return TypeInterval64
+}
+
+func (v interval64Value) MarshalJSON() ([]byte, error) {
+	return marshalJSON(v)
+}
+
+func (v interval64Value) toYDB(a *allocator.Allocator) *Ydb.Value {
+	vv := a.Int64()
+
+	vv.Int64Value = int64(v)
+
+	vvv := a.Value()
+	vvv.Value = vv
+
+	return vvv
+}
+
+func Interval64Value(v int64) interval64Value {
+	return interval64Value(v)
+}
+
 type jsonValue struct {
 	value string
 }
@@ -863,6 +1084,10 @@ func (*jsonValue) Type() Type {
 	return TypeJSON
 }
 
+func (v *jsonValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(v)
+}
+
 func (v *jsonValue) toYDB(a *allocator.Allocator) *Ydb.Value {
 	vv := a.Text()
 	if v != nil {
@@ -901,6 +1126,10 @@ func (*jsonDocumentValue) Type() Type {
 	return TypeJSONDocument
 }
 
+func (v *jsonDocumentValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(v)
+}
+
 func (v *jsonDocumentValue) toYDB(a *allocator.Allocator) *Ydb.Value {
 	vv := a.Text()
 	if v != nil {
@@ -940,6 +1169,10 @@ func (v *listValue) Type() Type {
 	return v.t
 }
 
+func (v *listValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(v)
+}
+
 func (v *listValue) toYDB(a *allocator.Allocator) *Ydb.Value {
 	var items []Value
 	if v != nil {
@@ -996,23 +1229,16 @@ func (v *nullValue) Type() Type {
 	return v.t
 }
 
+func (v *nullValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(v)
+}
+
+// toYDB returns null flag on top level for any depth of optional type.
+// Null flag in nested value means optional with NULL inside (such as Just(NULL))
 func (v *nullValue) toYDB(a *allocator.Allocator) *Ydb.Value {
 	vv := a.Value()
 	vv.Value = a.NullFlag()
 
-	x := v.t.innerType
-	for {
-		opt, ok := x.(*optionalType)
-		if !ok {
-			break
-		}
-		x = opt.innerType
-		nestedValue := a.Nested()
-		nestedValue.NestedValue = vv
-		vv = a.Value()
-		vv.Value = nestedValue
-	}
-
 	return vv
 }
 
@@ -1045,17 +1271,21 @@ func (v *optionalValue) Type() Type {
 	return v.innerType
 }
 
-func (v *optionalValue) toYDB(a *allocator.Allocator) *Ydb.Value {
-	vvv := a.Value()
+func (v *optionalValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(v)
+}
 
-	if _, opt := v.value.(*optionalValue); opt {
-		vv := a.Nested()
-		vv.NestedValue = v.value.toYDB(a)
-		vvv.Value = vv
-	} else {
-		vvv.Value = v.value.toYDB(a).Value
+func (v *optionalValue) toYDB(a *allocator.Allocator) *Ydb.Value {
+	if _, opt := untag(v.value.Type()).(*optionalType); !opt {
+		return v.value.toYDB(a)
 	}
 
+	vv := a.Nested()
+	vv.NestedValue = v.value.toYDB(a)
+
+	vvv := a.Value()
+	vvv.Value = vv
+
 	return vvv
 }
 
@@ -1095,6 +1325,10 @@ func (v *structValue) Type() Type {
 	return v.t
 }
 
+func (v *structValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(v)
+}
+
 func (v structValue) toYDB(a *allocator.Allocator) *Ydb.Value {
 	vvv := a.Value()
 
@@ -1140,6 +1374,10 @@ func (timestampValue) Type() Type {
 	return TypeTimestamp
 }
 
+func (v timestampValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(v)
+}
+
 func (v timestampValue) toYDB(a *allocator.Allocator) *Ydb.Value {
 	vv := a.Uint64()
 	vv.Uint64Value = uint64(v)
@@ -1154,6 +1392,45 @@ func TimestampValue(v uint64) timestampValue {
 	return timestampValue(v)
 }
 
+type timestamp64Value int64
+
+func (v timestamp64Value) toString(buffer *bytes.Buffer) {
+	a := allocator.New()
+	defer a.Free()
+	v.Type().toString(buffer)
+	valueToString(buffer, v.Type(), v.toYDB(a))
+}
+
+func (v timestamp64Value) String() string {
+	buf := allocator.Buffers.Get()
+	defer allocator.Buffers.Put(buf)
+	v.toString(buf)
+	return buf.String()
+}
+
+func (timestamp64Value) Type() Type {
+	return TypeTimestamp64
+}
+
+func (v timestamp64Value) MarshalJSON() ([]byte, error) {
+	return marshalJSON(v)
+}
+
+func (v timestamp64Value) toYDB(a *allocator.Allocator) *Ydb.Value {
+	vv := a.Int64()
+
+	vv.Int64Value = int64(v)
+
+	vvv := a.Value()
+	vvv.Value = vv
+
+	return vvv
+}
+
+func Timestamp64Value(v int64) timestamp64Value {
+	return timestamp64Value(v)
+}
+
 type tupleValue struct {
 	t     Type
 	items []Value
@@ -1177,6 +1454,10 @@ func (v *tupleValue) Type() Type {
 	return v.t
 }
 
+func (v *tupleValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(v)
+}
+
 func (v *tupleValue) toYDB(a *allocator.Allocator) *Ydb.Value {
 	var items []Value
 	if v != nil {
@@ -1224,6 +1505,10 @@ func (*tzDateValue) Type() Type {
 	return TypeTzDate
 }
 
+func (v *tzDateValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(v)
+}
+
 func (v *tzDateValue) toYDB(a *allocator.Allocator) *Ydb.Value {
 	vv := a.Text()
 	if v != nil {
@@ -1262,6 +1547,10 @@ func (*tzDatetimeValue) Type() Type {
 	return TypeTzDatetime
 }
 
+func (v *tzDatetimeValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(v)
+}
+
 func (v *tzDatetimeValue) toYDB(a *allocator.Allocator) *Ydb.Value {
 	vv := a.Text()
 	if v != nil {
@@ -1300,6 +1589,10 @@ func (*tzTimestampValue) Type() Type {
 	return TypeTzTimestamp
 }
 
+func (v *tzTimestampValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(v)
+}
+
 func (v *tzTimestampValue) toYDB(a *allocator.Allocator) *Ydb.Value {
 	vv := a.Text()
 	if v != nil {
@@ -1336,6 +1629,10 @@ func (uint8Value) Type() Type {
 	return TypeUint8
 }
 
+func (v uint8Value) MarshalJSON() ([]byte, error) {
+	return marshalJSON(v)
+}
+
 func (v uint8Value) toYDB(a *allocator.Allocator) *Ydb.Value {
 	vv := a.Uint32()
 	vv.Uint32Value = uint32(v)
@@ -1370,6 +1667,10 @@ func (uint16Value) Type() Type {
 	return TypeUint16
 }
 
+func (v uint16Value) MarshalJSON() ([]byte, error) {
+	return marshalJSON(v)
+}
+
 func (v uint16Value) toYDB(a *allocator.Allocator) *Ydb.Value {
 	vv := a.Uint32()
 	vv.Uint32Value = uint32(v)
@@ -1404,6 +1705,10 @@ func (uint32Value) Type() Type {
 	return TypeUint32
 }
 
+func (v uint32Value) MarshalJSON() ([]byte, error) {
+	return marshalJSON(v)
+}
+
 func (v uint32Value) toYDB(a *allocator.Allocator) *Ydb.Value {
 	vv := a.Uint32()
 	vv.Uint32Value = uint32(v)
@@ -1438,6 +1743,10 @@ func (uint64Value) Type() Type {
 	return TypeUint64
 }
 
+func (v uint64Value) MarshalJSON() ([]byte, error) {
+	return marshalJSON(v)
+}
+
 func (v uint64Value) toYDB(a *allocator.Allocator) *Ydb.Value {
 	vv := a.Uint64()
 	vv.Uint64Value = uint64(v)
@@ -1474,6 +1783,10 @@ func (*utf8Value) Type() Type {
 	return TypeUTF8
 }
 
+func (v *utf8Value) MarshalJSON() ([]byte, error) {
+	return marshalJSON(v)
+}
+
 func (v *utf8Value) toYDB(a *allocator.Allocator) *Ydb.Value {
 	vv := a.Text()
 	if v != nil {
@@ -1512,6 +1825,10 @@ func (*uuidValue) Type() Type {
 	return TypeUUID
 }
 
+func (v *uuidValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(v)
+}
+
 func (v *uuidValue) toYDB(a *allocator.Allocator) *Ydb.Value {
 	var bytes [16]byte
 	if v != nil {
@@ -1555,6 +1872,10 @@ func (v *variantValue) Type() Type {
 	return v.innerType
 }
 
+func (v *variantValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(v)
+}
+
 func (v *variantValue) toYDB(a *allocator.Allocator) *Ydb.Value {
 	vvv := a.Value()
 
@@ -1630,6 +1951,10 @@ func (voidValue) Type() Type {
 	return _voidValueType
 }
 
+func (v voidValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(v)
+}
+
 func (voidValue) toYDB(*allocator.Allocator) *Ydb.Value {
 	return _voidValue
 }
@@ -1660,6 +1985,10 @@ func (*ysonValue) Type() Type {
 	return TypeYSON
 }
 
+func (v *ysonValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(v)
+}
+
 func (v *ysonValue) toYDB(a *allocator.Allocator) *Ydb.Value {
 	vv := a.Text()
 	if v != nil {
@@ -1698,6 +2027,10 @@ func (v *zeroValue) Type() Type {
 	return v.t
 }
 
+func (v *zeroValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(v)
+}
+
 func (v *zeroValue) toYDB(a *allocator.Allocator) *Ydb.Value {
 	vv := a.Value()
 	switch t := v.t.(type) {
@@ -1706,7 +2039,7 @@ func (v *zeroValue) toYDB(a *allocator.Allocator) *Ydb.Value {
 		case TypeBool:
 			vv.Value = a.Bool()
 
-		case TypeInt8, TypeInt16, TypeInt32:
+		case TypeInt8, TypeInt16, TypeInt32, TypeDate32:
 			vv.Value = a.Int32()
 
 		case
@@ -1715,7 +2048,7 @@ func (v *zeroValue) toYDB(a *allocator.Allocator) *Ydb.Value {
 
 			vv.Value = a.Uint32()
 
-		case TypeInt64, TypeInterval:
+		case TypeInt64, TypeInterval, TypeDatetime64, TypeTimestamp64, TypeInterval64:
 
 			vv.Value = a.Int64()
 
@@ -1754,6 +2087,12 @@ func (v *zeroValue) toYDB(a *allocator.Allocator) *Ydb.Value {
 	case *DecimalType:
 		vv.Value = a.Low128()
 
+	case PgType:
+		vv.Value = a.Text()
+
+	case *taggedType:
+		return ZeroValue(t.innerType).toYDB(a)
+
 	case *variantType:
 		panic("do not know what to do with variant types for zero value")
 
@@ -1790,6 +2129,10 @@ func (stringValue) Type() Type {
 	return TypeString
 }
 
+func (v stringValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(v)
+}
+
 func (v stringValue) toYDB(a *allocator.Allocator) *Ydb.Value {
 	vv := a.Bytes()
 
@@ -158,8 +158,20 @@ func TestValueToString(t *testing.T) {
 		},
 		{
 			value: NullValue(Optional(TypeBool)),
+			exp:   "Optional<Optional<Bool>>(NULL)",
+		},
+		{
+			value: OptionalValue(NullValue(TypeBool)),
 			exp:   "Optional<Optional<Bool>>((NULL))",
 		},
+		{
+			value: TaggedValue("id", Int32Value(42)),
+			exp:   "Tagged<Int32,'id'>(42)",
+		},
+		{
+			value: OptionalValue(TaggedValue("it's", TupleValue(Int32Value(1), UTF8Value("2")))),
+			exp:   "Optional<Tagged<Tuple<Int32,Utf8>,'it\\'s'>>((1)(2))",
+		},
 		{
 			value: OptionalValue(OptionalValue(Int32Value(42))),
 			exp:   "Optional<Optional<Int32>>((42))",
@@ -297,6 +309,13 @@ func TestToYDBFromYDB(t *testing.T) {
 		),
 		NullValue(Primitive(TypeBool)),
 		NullValue(Optional(Primitive(TypeBool))),
+		OptionalValue(NullValue(Primitive(TypeBool))),
+		OptionalValue(OptionalValue(NullValue(TypeInt32))),
+		TaggedValue("tag", Int32Value(1)),
+		TaggedValue("tag", NullValue(TypeInt32)),
+		OptionalValue(TaggedValue("tag", StructValue(StructValueField{"id", Uint64Value(1)}))),
+		PgValue(25, "text"),
+		OptionalValue(PgValue(23, "42")),
 		VariantValue(Int32Value(42), 1, Tuple(
 			TypeString,
 			TypeInt32,
@@ -0,0 +1,173 @@
+package xsql
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	internal "github.com/ydb-platform/ydb-go-sdk/v3/internal/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+// BindMode defines how database/sql driver binds query args to YQL parameters
+type BindMode int
+
+const (
+	// NamedBind passes query and named args as is. Query must declare its parameters itself
+	NamedBind = BindMode(iota)
+
+	// PositionalBind rewrites positional placeholders `?` and numeric placeholders `$1`, `$2`, ...
+	// into named parameters `$p0`, `$p1`, ... and prepends query with DECLARE section
+	// generated from types of positional args
+	PositionalBind
+
+	DefaultBindMode = NamedBind
+)
+
+var errPlaceholders = errors.New("ydb: wrong placeholders")
+
+// positionalParamName returns name of YQL parameter for positional arg with zero-based index i
+func positionalParamName(i int) string {
+	return "$p" + strconv.Itoa(i)
+}
+
+// bindPositional rewrites positional placeholders in query with named parameters and
+// names unnamed args accordingly. String literals, quoted identifiers and comments are left intact.
+// Numeric placeholders must reference each positional arg
+//
+//nolint:gocyclo
+func bindPositional(query string, args []driver.NamedValue) (_ string, _ []driver.NamedValue, err error) {
+	var (
+		positional []int
+		buf        strings.Builder
+		questions  int
+		numeric    bool
+		referenced []bool
+	)
+	for i := range args {
+		if args[i].Name != "" {
+			continue
+		}
+		switch args[i].Value.(type) {
+		case table.ParameterOption, *table.QueryParameters:
+			continue
+		}
+		positional = append(positional, i)
+	}
+	buf.Grow(len(query))
+	for i := 0; i < len(query); i++ {
+		c := query[i]
+		switch {
+		case c == '\'' || c == '"' || c == '`':
+			j := i + 1
+			for ; j < len(query) && query[j] != c; j++ {
+				if query[j] == '\\' {
+					j++
+				}
+			}
+			if j >= len(query) {
+				return "", nil, xerrors.WithStackTrace(
+					fmt.Errorf("%w: unterminated quoted string at position %d", errPlaceholders, i),
+				)
+			}
+			buf.WriteString(query[i : j+1])
+			i = j
+		case c == '-' && i+1 < len(query) && query[i+1] == '-':
+			j := strings.IndexByte(query[i:], '\n')
+			if j < 0 {
+				j = len(query) - i - 1
+			}
+			buf.WriteString(query[i : i+j+1])
+			i += j
+		case c == '/' && i+1 < len(query) && query[i+1] == '*':
+			j := strings.Index(query[i+2:], "*/")
+			if j < 0 {
+				return "", nil, xerrors.WithStackTrace(
+					fmt.Errorf("%w: unterminated comment at position %d", errPlaceholders, i),
+				)
+			}
+			buf.WriteString(query[i : i+2+j+2])
+			i += 2 + j + 1
+		case c == '?':
+			if numeric {
+				return "", nil, xerrors.WithStackTrace(
+					fmt.Errorf("%w: mixed '?' and '$N' placeholders", errPlaceholders),
+				)
+			}
+			buf.WriteString(positionalParamName(questions))
+			questions++
+		case c == '$' && i+1 < len(query) && isDigit(query[i+1]):
+			if questions > 0 {
+				return "", nil, xerrors.WithStackTrace(
+					fmt.Errorf("%w: mixed '?' and '$N' placeholders", errPlaceholders),
+				)
+			}
+			j := i + 1
+			for j < len(query) && isDigit(query[j]) {
+				j++
+			}
+			n, err := strconv.Atoi(query[i+1 : j])
+			if err != nil || n < 1 || n > len(positional) {
+				return "", nil, xerrors.WithStackTrace(
+					fmt.Errorf("%w: placeholder %s out of range of %d positional args",
+						errPlaceholders, query[i:j], len(positional),
+					),
+				)
+			}
+			if !numeric {
+				numeric = true
+				referenced = make([]bool, len(positional))
+			}
+			referenced[n-1] = true
+			buf.WriteString(positionalParamName(n - 1))
+			i = j - 1
+		default:
+			buf.WriteByte(c)
+		}
+	}
+	if !numeric && questions != len(positional) {
+		return "", nil, xerrors.WithStackTrace(
+			fmt.Errorf("%w: query has %d placeholders but %d positional args given",
+				errPlaceholders, questions, len(positional),
+			),
+		)
+	}
+	for n, ok := range referenced {
+		if !ok {
+			return "", nil, xerrors.WithStackTrace(
+				fmt.Errorf("%w: positional arg %d is not referenced by '$%d' placeholder",
+					errPlaceholders, n+1, n+1,
+				),
+			)
+		}
+	}
+	if len(positional) == 0 {
+		return buf.String(), args, nil
+	}
+	var (
+		bound  = make([]driver.NamedValue, len(args))
+		params = make([]table.ParameterOption, 0, len(positional))
+	)
+	copy(bound, args)
+	for i, j := range positional {
+		v, ok := bound[j].Value.(types.Value)
+		if !ok {
+			return "", nil, xerrors.WithStackTrace(fmt.Errorf("ydb: unsupported type: %T", bound[j].Value))
+		}
+		bound[j].Name = positionalParamName(i)
+		params = append(params, table.ValueParam(bound[j].Name, v))
+	}
+	declares, err := internal.GenerateDeclareSection(table.NewQueryParameters(params...))
+	if err != nil {
+		return "", nil, xerrors.WithStackTrace(err)
+	}
+	return declares + buf.String(), bound, nil
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
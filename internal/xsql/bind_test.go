@@ -0,0 +1,171 @@
+package xsql
+
+import (
+	"database/sql/driver"
+	"errors"
+	"testing"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/yql"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+func TestBindPositional(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		query string
+		args  []driver.NamedValue
+		exp   string
+		names []string
+		err   error
+	}{
+		{
+			name:  "question marks",
+			query: "SELECT * FROM t WHERE a = ? AND b = ?",
+			args: []driver.NamedValue{
+				{Ordinal: 1, Value: types.Int64Value(1)},
+				{Ordinal: 2, Value: types.UTF8Value("b")},
+			},
+			exp: "DECLARE $p0 AS Int64;\n" +
+				"DECLARE $p1 AS Utf8;\n" +
+				"SELECT * FROM t WHERE a = $p0 AND b = $p1",
+			names: []string{"$p0", "$p1"},
+		},
+		{
+			name:  "numeric",
+			query: "SELECT $2, $1, $2",
+			args: []driver.NamedValue{
+				{Ordinal: 1, Value: types.Int32Value(1)},
+				{Ordinal: 2, Value: types.OptionalValue(types.BoolValue(true))},
+			},
+			exp: "DECLARE $p0 AS Int32;\n" +
+				"DECLARE $p1 AS Optional<Bool>;\n" +
+				"SELECT $p1, $p0, $p1",
+			names: []string{"$p0", "$p1"},
+		},
+		{
+			name: "literals and comments",
+			query: "-- a = ?\n" +
+				"/* b = $1 */\n" +
+				"SELECT '?', \"it\\\"s ?\", `?` FROM t WHERE a = ?; -- ?",
+			args: []driver.NamedValue{
+				{Ordinal: 1, Value: types.Uint64Value(1)},
+			},
+			exp: "DECLARE $p0 AS Uint64;\n" +
+				"-- a = ?\n" +
+				"/* b = $1 */\n" +
+				"SELECT '?', \"it\\\"s ?\", `?` FROM t WHERE a = $p0; -- ?",
+			names: []string{"$p0"},
+		},
+		{
+			name:  "quoted identifier with escaped backtick",
+			query: "SELECT " + yql.QuoteIdentifier("a`b?") + " FROM t WHERE a = ?",
+			args: []driver.NamedValue{
+				{Ordinal: 1, Value: types.Int64Value(1)},
+			},
+			exp: "DECLARE $p0 AS Int64;\n" +
+				"SELECT `a\\`b?` FROM t WHERE a = $p0",
+			names: []string{"$p0"},
+		},
+		{
+			name:  "named args left as is",
+			query: "DECLARE $a AS Int64; SELECT $a, ?",
+			args: []driver.NamedValue{
+				{Ordinal: 1, Name: "a", Value: types.Int64Value(1)},
+				{Ordinal: 2, Value: types.Int64Value(2)},
+			},
+			exp: "DECLARE $p0 AS Int64;\n" +
+				"DECLARE $a AS Int64; SELECT $a, $p0",
+			names: []string{"a", "$p0"},
+		},
+		{
+			name:  "query parameters",
+			query: "DECLARE $a AS Int64; SELECT $a",
+			args: []driver.NamedValue{
+				{Ordinal: 1, Value: table.NewQueryParameters(table.ValueParam("$a", types.Int64Value(1)))},
+			},
+			exp:   "DECLARE $a AS Int64; SELECT $a",
+			names: []string{""},
+		},
+		{
+			name:  "not enough args",
+			query: "SELECT ?, ?",
+			args: []driver.NamedValue{
+				{Ordinal: 1, Value: types.Int64Value(1)},
+			},
+			err: errPlaceholders,
+		},
+		{
+			name:  "numeric out of range",
+			query: "SELECT $2",
+			args: []driver.NamedValue{
+				{Ordinal: 1, Value: types.Int64Value(1)},
+			},
+			err: errPlaceholders,
+		},
+		{
+			name:  "numeric not referenced",
+			query: "SELECT $2",
+			args: []driver.NamedValue{
+				{Ordinal: 1, Value: types.Int64Value(1)},
+				{Ordinal: 2, Value: types.Int64Value(2)},
+			},
+			err: errPlaceholders,
+		},
+		{
+			name:  "mixed",
+			query: "SELECT ?, $1",
+			args: []driver.NamedValue{
+				{Ordinal: 1, Value: types.Int64Value(1)},
+			},
+			err: errPlaceholders,
+		},
+		{
+			name:  "unterminated literal",
+			query: "SELECT 'abc, ?",
+			args: []driver.NamedValue{
+				{Ordinal: 1, Value: types.Int64Value(1)},
+			},
+			err: errPlaceholders,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			query, args, err := bindPositional(tt.query, tt.args)
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("unexpected error: %v; want %v", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if query != tt.exp {
+				t.Errorf("unexpected query:\n%s\nwant:\n%s", query, tt.exp)
+			}
+			for i, arg := range args {
+				if arg.Name != tt.names[i] {
+					t.Errorf("unexpected name of arg %d: %q; want %q", i, arg.Name, tt.names[i])
+				}
+			}
+			for i, arg := range tt.args {
+				if arg.Name != "" && arg.Name != tt.names[i] {
+					t.Errorf("source args modified: %+v", tt.args)
+				}
+			}
+		})
+	}
+}
+
+func TestCheckPositionalValue(t *testing.T) {
+	v := driver.NamedValue{Ordinal: 1, Value: int64(1)}
+	if err := checkPositionalValue(&v); err != nil {
+		t.Fatal(err)
+	}
+	if vv, ok := v.Value.(types.Value); !ok || vv.String() != types.Int64Value(1).String() {
+		t.Errorf("unexpected value: %v", v.Value)
+	}
+	if err := checkNamedValue(&driver.NamedValue{Ordinal: 1, Value: int64(1)}); err == nil {
+		t.Errorf("expected error of unnamed arg in named bind mode")
+	}
+}
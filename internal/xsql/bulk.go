@@ -0,0 +1,93 @@
+package xsql
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"path"
+	"reflect"
+	"strings"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+// bulkUpsert uploads rows from single arg into table with path tablePath with retries.
+// Relative table path is joined with database name
+func (c *conn) bulkUpsert(ctx context.Context, tablePath string, args []driver.NamedValue) (driver.Result, error) {
+	if len(args) != 1 {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("ydb: bulk upsert requires single rows arg, got %d args", len(args)))
+	}
+	rows, count, err := bulkUpsertRows(args[0].Value)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	tablePath = strings.TrimSpace(tablePath)
+	if !strings.HasPrefix(tablePath, "/") {
+		tablePath = path.Join(c.connector.connection.Name(), tablePath)
+	}
+	err = c.connector.connection.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
+		return s.BulkUpsert(ctx, tablePath, rows)
+	}, table.WithIdempotent())
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	return driver.RowsAffected(count), nil
+}
+
+// isBulkUpsertRows reports whether v is a slice of structs (or pointers to structs)
+func isBulkUpsertRows(v interface{}) bool {
+	t := reflect.TypeOf(v)
+	if t == nil || t.Kind() != reflect.Slice {
+		return false
+	}
+	t = t.Elem()
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.Kind() == reflect.Struct && t != typeTime && t != typeDecimal
+}
+
+// bulkUpsertRows makes list of rows for bulk upsert from list value or slice of structs.
+// Struct fields maps to columns as in options.NewDescriptionFromStruct: column name defines
+// with `ydb` field tag and all columns are optional
+func bulkUpsertRows(v interface{}) (rows types.Value, count int, err error) {
+	if vv, ok := v.(types.Value); ok {
+		a := allocator.New()
+		defer a.Free()
+		typedValue := value.ToYDB(vv, a)
+		if _, ok := value.UntagYDB(typedValue.Type).Type.(*Ydb.Type_ListType); !ok {
+			return nil, 0, xerrors.WithStackTrace(fmt.Errorf("ydb: bulk upsert rows must be a list, got %s", vv.Type()))
+		}
+		return vv, len(typedValue.Value.Items), nil
+	}
+	if !isBulkUpsertRows(v) {
+		return nil, 0, xerrors.WithStackTrace(
+			fmt.Errorf("ydb: bulk upsert rows must be a list value or a slice of structs, got %T", v),
+		)
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Len() == 0 {
+		return nil, 0, xerrors.WithStackTrace(fmt.Errorf("ydb: empty bulk upsert rows"))
+	}
+	items := make([]types.Value, rv.Len())
+	for i := range items {
+		item := rv.Index(i)
+		if item.Kind() == reflect.Ptr {
+			if item.IsNil() {
+				return nil, 0, xerrors.WithStackTrace(fmt.Errorf("ydb: nil bulk upsert row %d", i))
+			}
+			item = item.Elem()
+		}
+		items[i], err = structToValue(item, true)
+		if err != nil {
+			return nil, 0, xerrors.WithStackTrace(fmt.Errorf("ydb: bulk upsert row %d: %w", i, err))
+		}
+	}
+	return types.ListValue(items...), len(items), nil
+}
@@ -0,0 +1,146 @@
+package xsql
+
+import (
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+type bulkStatus int32
+
+type bulkRow struct {
+	ID       uint64 `ydb:"id,pk"`
+	Title    *string
+	Status   bulkStatus `ydb:"status"`
+	Count    int
+	Released time.Time `ydb:"released"`
+	Skipped  string    `ydb:"-"`
+	internal string
+}
+
+func TestBulkUpsertRows(t *testing.T) {
+	title := "b"
+	released := time.Unix(1, 0)
+	for _, tt := range []struct {
+		name  string
+		rows  interface{}
+		exp   types.Value
+		count int
+		err   bool
+	}{
+		{
+			name: "structs",
+			rows: []bulkRow{
+				{ID: 1, Status: 2, Count: 3, Released: released, Skipped: "a", internal: "b"},
+				{ID: 2, Title: &title, Released: released},
+			},
+			exp: types.ListValue(
+				types.StructValue(
+					types.StructFieldValue("id", types.OptionalValue(types.Uint64Value(1))),
+					types.StructFieldValue("Title", types.NullValue(types.TypeUTF8)),
+					types.StructFieldValue("status", types.OptionalValue(types.Int32Value(2))),
+					types.StructFieldValue("Count", types.OptionalValue(types.Int64Value(3))),
+					types.StructFieldValue("released", types.OptionalValue(types.TimestampValueFromTime(released))),
+				),
+				types.StructValue(
+					types.StructFieldValue("id", types.OptionalValue(types.Uint64Value(2))),
+					types.StructFieldValue("Title", types.OptionalValue(types.TextValue("b"))),
+					types.StructFieldValue("status", types.OptionalValue(types.Int32Value(0))),
+					types.StructFieldValue("Count", types.OptionalValue(types.Int64Value(0))),
+					types.StructFieldValue("released", types.OptionalValue(types.TimestampValueFromTime(released))),
+				),
+			),
+			count: 2,
+		},
+		{
+			name: "pointers to structs",
+			rows: []*bulkRow{
+				{ID: 1, Released: released},
+			},
+			exp: types.ListValue(
+				types.StructValue(
+					types.StructFieldValue("id", types.OptionalValue(types.Uint64Value(1))),
+					types.StructFieldValue("Title", types.NullValue(types.TypeUTF8)),
+					types.StructFieldValue("status", types.OptionalValue(types.Int32Value(0))),
+					types.StructFieldValue("Count", types.OptionalValue(types.Int64Value(0))),
+					types.StructFieldValue("released", types.OptionalValue(types.TimestampValueFromTime(released))),
+				),
+			),
+			count: 1,
+		},
+		{
+			name: "list value",
+			rows: types.ListValue(
+				types.StructValue(types.StructFieldValue("id", types.Uint64Value(1))),
+				types.StructValue(types.StructFieldValue("id", types.Uint64Value(2))),
+				types.StructValue(types.StructFieldValue("id", types.Uint64Value(3))),
+			),
+			exp: types.ListValue(
+				types.StructValue(types.StructFieldValue("id", types.Uint64Value(1))),
+				types.StructValue(types.StructFieldValue("id", types.Uint64Value(2))),
+				types.StructValue(types.StructFieldValue("id", types.Uint64Value(3))),
+			),
+			count: 3,
+		},
+		{
+			name: "not a list value",
+			rows: types.Uint64Value(1),
+			err:  true,
+		},
+		{
+			name: "empty slice",
+			rows: []bulkRow{},
+			err:  true,
+		},
+		{
+			name: "nil row",
+			rows: []*bulkRow{nil},
+			err:  true,
+		},
+		{
+			name: "slice of times",
+			rows: []time.Time{released},
+			err:  true,
+		},
+		{
+			name: "unsupported field",
+			rows: []struct{ F chan int }{{}},
+			err:  true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, count, err := bulkUpsertRows(tt.rows)
+			if tt.err {
+				if err == nil {
+					t.Fatalf("expected error, got %v", rows)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if rows.String() != tt.exp.String() {
+				t.Errorf("unexpected rows:\n%s\nwant:\n%s", rows, tt.exp)
+			}
+			if count != tt.count {
+				t.Errorf("unexpected count: %d; want %d", count, tt.count)
+			}
+		})
+	}
+}
+
+func TestCheckNamedValueBulkUpsertRows(t *testing.T) {
+	for _, v := range []interface{}{
+		[]bulkRow{{ID: 1}},
+		types.ListValue(types.StructValue(types.StructFieldValue("id", types.Uint64Value(1)))),
+	} {
+		if err := checkNamedValue(&driver.NamedValue{Ordinal: 1, Value: v}); err != nil {
+			t.Errorf("unexpected error for %T: %v", v, err)
+		}
+	}
+	if _, err := toQueryParams([]driver.NamedValue{{Ordinal: 1, Value: types.Uint64Value(1)}}); err == nil {
+		t.Errorf("expected error of unnamed query parameter")
+	}
+}
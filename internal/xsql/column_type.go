@@ -0,0 +1,135 @@
+package xsql
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+// unwrapColumnType removes optional and tagged wrappers from column type.
+// Returns true if column type is optional (nullable)
+func unwrapColumnType(t types.Type) (_ types.Type, nullable bool) {
+	for {
+		if inner, ok := value.OptionalInnerType(t); ok {
+			t, nullable = inner, true
+			continue
+		}
+		if _, inner, ok := value.TaggedInnerType(t); ok {
+			t = inner
+			continue
+		}
+		return t, nullable
+	}
+}
+
+// columnTypeDatabaseTypeName returns YQL name of column type without Optional wrapper and
+// without precision and scale of Decimal, such as "Int32", "Utf8", "Decimal" or "List<Int32>"
+func columnTypeDatabaseTypeName(t types.Type) string {
+	if t == nil {
+		return ""
+	}
+	t, _ = unwrapColumnType(t)
+	if _, ok := t.(*value.DecimalType); ok {
+		return "Decimal"
+	}
+	return t.String()
+}
+
+func columnTypeNullable(t types.Type) (nullable, ok bool) {
+	if t == nil {
+		return false, false
+	}
+	if _, nullable = unwrapColumnType(t); nullable {
+		return true, true
+	}
+	return value.TypesEqual(t, value.Void()), true
+}
+
+var (
+	typeOfBool     = reflect.TypeOf(false)
+	typeOfInt8     = reflect.TypeOf(int8(0))
+	typeOfInt16    = reflect.TypeOf(int16(0))
+	typeOfInt32    = reflect.TypeOf(int32(0))
+	typeOfInt64    = reflect.TypeOf(int64(0))
+	typeOfUint8    = reflect.TypeOf(uint8(0))
+	typeOfUint16   = reflect.TypeOf(uint16(0))
+	typeOfUint32   = reflect.TypeOf(uint32(0))
+	typeOfUint64   = reflect.TypeOf(uint64(0))
+	typeOfFloat32  = reflect.TypeOf(float32(0))
+	typeOfFloat64  = reflect.TypeOf(float64(0))
+	typeOfString   = reflect.TypeOf("")
+	typeOfBytes    = reflect.TypeOf([]byte(nil))
+	typeOfUUID     = reflect.TypeOf([16]byte{})
+	typeOfTime     = reflect.TypeOf(time.Time{})
+	typeOfDuration = reflect.TypeOf(time.Duration(0))
+	typeOfDecimal  = reflect.TypeOf(types.Decimal{})
+	typeOfAny      = reflect.TypeOf((*interface{})(nil)).Elem()
+)
+
+// columnTypeScanType returns Go type of values which rows returns for column type
+//
+//nolint:gocyclo
+func columnTypeScanType(t types.Type) reflect.Type {
+	if t == nil {
+		return typeOfAny
+	}
+	t, _ = unwrapColumnType(t)
+	switch tt := t.(type) {
+	case *value.DecimalType:
+		return typeOfDecimal
+	case value.PgType:
+		return typeOfString
+	case value.PrimitiveType:
+		switch tt {
+		case types.TypeBool:
+			return typeOfBool
+		case types.TypeInt8:
+			return typeOfInt8
+		case types.TypeInt16:
+			return typeOfInt16
+		case types.TypeInt32:
+			return typeOfInt32
+		case types.TypeInt64:
+			return typeOfInt64
+		case types.TypeUint8:
+			return typeOfUint8
+		case types.TypeUint16:
+			return typeOfUint16
+		case types.TypeUint32:
+			return typeOfUint32
+		case types.TypeUint64:
+			return typeOfUint64
+		case types.TypeFloat:
+			return typeOfFloat32
+		case types.TypeDouble:
+			return typeOfFloat64
+		case
+			types.TypeDate, types.TypeDatetime, types.TypeTimestamp,
+			types.TypeDate32, types.TypeDatetime64, types.TypeTimestamp64,
+			types.TypeTzDate, types.TypeTzDatetime, types.TypeTzTimestamp:
+			return typeOfTime
+		case types.TypeInterval, types.TypeInterval64:
+			return typeOfDuration
+		case types.TypeUTF8, types.TypeDyNumber:
+			return typeOfString
+		case types.TypeString, types.TypeYSON, types.TypeJSON, types.TypeJSONDocument:
+			return typeOfBytes
+		case types.TypeUUID:
+			return typeOfUUID
+		}
+	}
+	return typeOfAny
+}
+
+func columnTypePrecisionScale(t types.Type) (precision, scale int64, ok bool) {
+	if t == nil {
+		return 0, 0, false
+	}
+	t, _ = unwrapColumnType(t)
+	if d, ok := t.(*value.DecimalType); ok {
+		return int64(d.Precision), int64(d.Scale), true
+	}
+	return 0, 0, false
+}
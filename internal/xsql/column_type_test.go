@@ -0,0 +1,80 @@
+package xsql
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+func TestColumnType(t *testing.T) {
+	for _, tt := range []struct {
+		t         types.Type
+		name      string
+		nullable  bool
+		scanType  reflect.Type
+		precision int64
+		scale     int64
+		decimal   bool
+	}{
+		{
+			t:        types.TypeInt32,
+			name:     "Int32",
+			scanType: reflect.TypeOf(int32(0)),
+		},
+		{
+			t:        types.Optional(types.TypeUTF8),
+			name:     "Utf8",
+			nullable: true,
+			scanType: reflect.TypeOf(""),
+		},
+		{
+			t:        types.Optional(types.Optional(types.TypeTimestamp)),
+			name:     "Timestamp",
+			nullable: true,
+			scanType: reflect.TypeOf(time.Time{}),
+		},
+		{
+			t:        types.Tagged("id", types.TypeJSON),
+			name:     "Json",
+			scanType: reflect.TypeOf([]byte(nil)),
+		},
+		{
+			t:         types.Optional(types.DecimalType(22, 9)),
+			name:      "Decimal",
+			nullable:  true,
+			scanType:  reflect.TypeOf(types.Decimal{}),
+			precision: 22,
+			scale:     9,
+			decimal:   true,
+		},
+		{
+			t:        types.List(types.TypeInt32),
+			name:     "List<Int32>",
+			scanType: reflect.TypeOf((*interface{})(nil)).Elem(),
+		},
+		{
+			t:        types.Void(),
+			name:     "Void",
+			nullable: true,
+			scanType: reflect.TypeOf((*interface{})(nil)).Elem(),
+		},
+	} {
+		t.Run(tt.t.String(), func(t *testing.T) {
+			if name := columnTypeDatabaseTypeName(tt.t); name != tt.name {
+				t.Errorf("unexpected database type name: %q; want %q", name, tt.name)
+			}
+			if nullable, ok := columnTypeNullable(tt.t); !ok || nullable != tt.nullable {
+				t.Errorf("unexpected nullable: %v, %v; want %v", nullable, ok, tt.nullable)
+			}
+			if scanType := columnTypeScanType(tt.t); scanType != tt.scanType {
+				t.Errorf("unexpected scan type: %v; want %v", scanType, tt.scanType)
+			}
+			precision, scale, ok := columnTypePrecisionScale(tt.t)
+			if ok != tt.decimal || precision != tt.precision || scale != tt.scale {
+				t.Errorf("unexpected precision and scale: %d, %d, %v", precision, scale, ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,354 @@
+package xsql
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+// structTag is a tag of struct fields which defines column name (as in options.NewDescriptionFromStruct)
+const structTag = "ydb"
+
+var (
+	typeTime    = reflect.TypeOf(time.Time{})
+	typeDecimal = reflect.TypeOf(types.Decimal{})
+)
+
+// kindTypes maps kinds of named go types to underlying types which primitiveToValue supports
+var kindTypes = map[reflect.Kind]reflect.Type{
+	reflect.Bool:    reflect.TypeOf(false),
+	reflect.Int:     reflect.TypeOf(int64(0)),
+	reflect.Int8:    reflect.TypeOf(int8(0)),
+	reflect.Int16:   reflect.TypeOf(int16(0)),
+	reflect.Int32:   reflect.TypeOf(int32(0)),
+	reflect.Int64:   reflect.TypeOf(int64(0)),
+	reflect.Uint:    reflect.TypeOf(uint64(0)),
+	reflect.Uint8:   reflect.TypeOf(uint8(0)),
+	reflect.Uint16:  reflect.TypeOf(uint16(0)),
+	reflect.Uint32:  reflect.TypeOf(uint32(0)),
+	reflect.Uint64:  reflect.TypeOf(uint64(0)),
+	reflect.Float32: reflect.TypeOf(float32(0)),
+	reflect.Float64: reflect.TypeOf(float64(0)),
+	reflect.String:  reflect.TypeOf(""),
+}
+
+// toValue converts query arg to YDB value. Args which primitiveToValue not supports
+// (named types, slices, arrays, maps and structs) converts with goToValue
+func toValue(v interface{}) (types.Value, error) {
+	value, err := primitiveToValue(v)
+	if err == nil {
+		return value, nil
+	}
+	if t := reflect.TypeOf(v); t == nil || t == reflect.PtrTo(typeDecimal) {
+		return nil, err
+	}
+	return goToValue(reflect.ValueOf(v))
+}
+
+// goToValue converts go value to YDB value. Pointers converts to optional values,
+// named types converts as their underlying types, slices and arrays converts to List,
+// maps converts to Dict and structs converts to Struct
+func goToValue(v reflect.Value) (types.Value, error) {
+	if v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("ydb: unsupported nil value of %s", v.Type()))
+		}
+		return goToValue(v.Elem())
+	}
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			zero, err := goToValue(reflect.Zero(v.Type().Elem()))
+			if err != nil {
+				return nil, xerrors.WithStackTrace(err)
+			}
+			return types.NullValue(zero.Type()), nil
+		}
+		vv, err := goToValue(v.Elem())
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		return types.OptionalValue(vv), nil
+	}
+	vv, err := primitiveToValue(v.Interface())
+	if err == nil {
+		return vv, nil
+	}
+	if t, has := kindTypes[v.Kind()]; has && v.Type() != t {
+		return primitiveToValue(v.Convert(t).Interface())
+	}
+	switch v.Kind() {
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return types.BytesValue(v.Bytes()), nil
+		}
+		return listToValue(v)
+	case reflect.Array:
+		return listToValue(v)
+	case reflect.Map:
+		return dictToValue(v)
+	case reflect.Struct:
+		return structToValue(v, false)
+	default:
+		return nil, xerrors.WithStackTrace(err)
+	}
+}
+
+func listToValue(v reflect.Value) (types.Value, error) {
+	if v.Len() == 0 {
+		item, err := goToValue(reflect.Zero(v.Type().Elem()))
+		if err != nil {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("unknown type of items of empty %s: %w", v.Type(), err))
+		}
+		return types.ZeroValue(types.List(item.Type())), nil
+	}
+	items := make([]types.Value, v.Len())
+	for i := range items {
+		item, err := goToValue(v.Index(i))
+		if err != nil {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("list item %d: %w", i, err))
+		}
+		if i > 0 && !value.TypesEqual(item.Type(), items[0].Type()) {
+			return nil, xerrors.WithStackTrace(fmt.Errorf(
+				"different types of list items: %s and %s", items[0].Type(), item.Type(),
+			))
+		}
+		items[i] = item
+	}
+	return types.ListValue(items...), nil
+}
+
+// dictToValue converts map to Dict value with pairs sorted by keys for stable query params
+func dictToValue(v reflect.Value) (types.Value, error) {
+	if v.Len() == 0 {
+		k, err := goToValue(reflect.Zero(v.Type().Key()))
+		if err != nil {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("unknown type of keys of empty %s: %w", v.Type(), err))
+		}
+		p, err := goToValue(reflect.Zero(v.Type().Elem()))
+		if err != nil {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("unknown type of values of empty %s: %w", v.Type(), err))
+		}
+		return types.ZeroValue(types.Dict(k.Type(), p.Type())), nil
+	}
+	type pair struct {
+		k, v types.Value
+		s    string
+	}
+	pairs := make([]pair, 0, v.Len())
+	iter := v.MapRange()
+	for iter.Next() {
+		k, err := goToValue(iter.Key())
+		if err != nil {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("dict key %v: %w", iter.Key(), err))
+		}
+		p, err := goToValue(iter.Value())
+		if err != nil {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("dict value of key %v: %w", iter.Key(), err))
+		}
+		if len(pairs) > 0 && (!value.TypesEqual(k.Type(), pairs[0].k.Type()) ||
+			!value.TypesEqual(p.Type(), pairs[0].v.Type())) {
+			return nil, xerrors.WithStackTrace(fmt.Errorf(
+				"different types of dict pairs: <%s,%s> and <%s,%s>",
+				pairs[0].k.Type(), pairs[0].v.Type(), k.Type(), p.Type(),
+			))
+		}
+		pairs = append(pairs, pair{k: k, v: p, s: k.String()})
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		return pairs[i].s < pairs[j].s
+	})
+	fields := make([]types.DictValueOption, len(pairs))
+	for i, p := range pairs {
+		fields[i] = types.DictFieldValue(p.k, p.v)
+	}
+	return types.DictValue(fields...), nil
+}
+
+// fieldName returns name of struct field in YDB struct or table row.
+// Returns false for unexported fields and fields with `ydb:"-"` tag
+func fieldName(f reflect.StructField) (string, bool) {
+	if f.PkgPath != "" {
+		return "", false
+	}
+	name := f.Tag.Get(structTag)
+	if name == "-" {
+		return "", false
+	}
+	if i := strings.IndexByte(name, ','); i >= 0 {
+		name = name[:i]
+	}
+	if name == "" {
+		name = f.Name
+	}
+	return name, true
+}
+
+// structToValue converts go struct to Struct value. Fields maps to struct members
+// as in options.NewDescriptionFromStruct. If optional is true all members are optional
+func structToValue(v reflect.Value, optional bool) (types.Value, error) {
+	var (
+		t      = v.Type()
+		fields = make([]types.StructValueOption, 0, t.NumField())
+	)
+	for i := 0; i < t.NumField(); i++ {
+		name, ok := fieldName(t.Field(i))
+		if !ok {
+			continue
+		}
+		fv, err := goToValue(v.Field(i))
+		if err != nil {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("field %q: %w", t.Field(i).Name, err))
+		}
+		if _, isOptional := value.OptionalInnerType(fv.Type()); optional && !isOptional {
+			fv = types.OptionalValue(fv)
+		}
+		fields = append(fields, types.StructFieldValue(name, fv))
+	}
+	return types.StructValue(fields...), nil
+}
+
+type compositeScanner struct {
+	dst interface{}
+}
+
+// ScanComposite returns sql.Scanner which scans values of List, Tuple, Struct and Dict columns into dst.
+// dst must be a pointer to go value such as slice, map or struct with fields tagged as in
+// options.NewDescriptionFromStruct. Items converts into items of dst with numeric conversions,
+// NULL values sets zero values
+func ScanComposite(dst interface{}) sql.Scanner {
+	return compositeScanner{dst: dst}
+}
+
+func (s compositeScanner) Scan(src interface{}) error {
+	dst := reflect.ValueOf(s.dst)
+	if dst.Kind() != reflect.Ptr || dst.IsNil() {
+		return xerrors.WithStackTrace(fmt.Errorf("ydb: scan destination must be a non-nil pointer, got %T", s.dst))
+	}
+	if err := assign(dst.Elem(), reflect.ValueOf(src)); err != nil {
+		return xerrors.WithStackTrace(fmt.Errorf("ydb: cannot scan %T into %T: %w", src, s.dst, err))
+	}
+	return nil
+}
+
+// assign sets dst to go value src which rows returns for YDB value
+//
+//nolint:gocyclo
+func assign(dst, src reflect.Value) error {
+	for src.IsValid() && (src.Kind() == reflect.Interface || src.Kind() == reflect.Ptr) && !src.IsNil() &&
+		!src.Type().AssignableTo(dst.Type()) {
+		src = src.Elem()
+	}
+	if !src.IsValid() || ((src.Kind() == reflect.Interface || src.Kind() == reflect.Ptr) && src.IsNil()) {
+		dst.Set(reflect.Zero(dst.Type()))
+		return nil
+	}
+	if src.Type().AssignableTo(dst.Type()) {
+		dst.Set(src)
+		return nil
+	}
+	switch dst.Kind() {
+	case reflect.Ptr:
+		p := reflect.New(dst.Type().Elem())
+		if err := assign(p.Elem(), src); err != nil {
+			return err
+		}
+		dst.Set(p)
+		return nil
+	case reflect.Slice:
+		if src.Kind() != reflect.Slice && src.Kind() != reflect.Array {
+			break
+		}
+		if dst.Type().Elem().Kind() == reflect.Uint8 && src.Kind() == reflect.String {
+			break
+		}
+		v := reflect.MakeSlice(dst.Type(), src.Len(), src.Len())
+		for i := 0; i < src.Len(); i++ {
+			if err := assign(v.Index(i), src.Index(i)); err != nil {
+				return fmt.Errorf("item %d: %w", i, err)
+			}
+		}
+		dst.Set(v)
+		return nil
+	case reflect.Array:
+		if (src.Kind() != reflect.Slice && src.Kind() != reflect.Array) || src.Len() != dst.Len() {
+			break
+		}
+		for i := 0; i < src.Len(); i++ {
+			if err := assign(dst.Index(i), src.Index(i)); err != nil {
+				return fmt.Errorf("item %d: %w", i, err)
+			}
+		}
+		return nil
+	case reflect.Map:
+		if src.Kind() != reflect.Map {
+			break
+		}
+		v := reflect.MakeMapWithSize(dst.Type(), src.Len())
+		iter := src.MapRange()
+		for iter.Next() {
+			k := reflect.New(dst.Type().Key()).Elem()
+			if err := assign(k, iter.Key()); err != nil {
+				return fmt.Errorf("key %v: %w", iter.Key(), err)
+			}
+			e := reflect.New(dst.Type().Elem()).Elem()
+			if err := assign(e, iter.Value()); err != nil {
+				return fmt.Errorf("value of key %v: %w", iter.Key(), err)
+			}
+			v.SetMapIndex(k, e)
+		}
+		dst.Set(v)
+		return nil
+	case reflect.Struct:
+		if src.Kind() != reflect.Map || src.Type().Key().Kind() != reflect.String {
+			break
+		}
+		for i := 0; i < dst.NumField(); i++ {
+			name, ok := fieldName(dst.Type().Field(i))
+			if !ok {
+				continue
+			}
+			f := src.MapIndex(reflect.ValueOf(name).Convert(src.Type().Key()))
+			if !f.IsValid() {
+				continue
+			}
+			if err := assign(dst.Field(i), f); err != nil {
+				return fmt.Errorf("field %q: %w", dst.Type().Field(i).Name, err)
+			}
+		}
+		return nil
+	}
+	if convertible(src.Type(), dst.Type()) {
+		dst.Set(src.Convert(dst.Type()))
+		return nil
+	}
+	return fmt.Errorf("cannot assign %s to %s", src.Type(), dst.Type())
+}
+
+// convertible reports whether values of type src converts to type dst without changing meaning
+// (numbers to numbers, strings to strings or bytes and named types to their underlying types)
+func convertible(src, dst reflect.Type) bool {
+	if !src.ConvertibleTo(dst) {
+		return false
+	}
+	isNumber := func(k reflect.Kind) bool {
+		return k >= reflect.Int && k <= reflect.Float64
+	}
+	isText := func(t reflect.Type) bool {
+		return t.Kind() == reflect.String || (t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8)
+	}
+	switch {
+	case isNumber(src.Kind()):
+		return isNumber(dst.Kind())
+	case isText(src):
+		return isText(dst)
+	default:
+		return src.Kind() == dst.Kind()
+	}
+}
@@ -0,0 +1,199 @@
+package xsql
+
+import (
+	"testing"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+type compositeID int64
+
+type compositeSeries struct {
+	ID      uint64 `ydb:"series_id"`
+	Title   *string
+	Tags    []string `ydb:"tags"`
+	Skipped string   `ydb:"-"`
+}
+
+func TestToValue(t *testing.T) {
+	title := "a"
+	for _, tt := range []struct {
+		name string
+		v    interface{}
+		exp  types.Value
+		err  bool
+	}{
+		{
+			name: "slice",
+			v:    []int64{1, 2},
+			exp:  types.ListValue(types.Int64Value(1), types.Int64Value(2)),
+		},
+		{
+			name: "empty slice",
+			v:    []string{},
+			exp:  types.ZeroValue(types.List(types.TypeUTF8)),
+		},
+		{
+			name: "slice of named types",
+			v:    []compositeID{1},
+			exp:  types.ListValue(types.Int64Value(1)),
+		},
+		{
+			name: "slice of pointers",
+			v:    []*string{&title, nil},
+			exp:  types.ListValue(types.OptionalValue(types.TextValue("a")), types.NullValue(types.TypeUTF8)),
+		},
+		{
+			name: "array",
+			v:    [2]uint8{1, 2},
+			exp:  types.ListValue(types.Uint8Value(1), types.Uint8Value(2)),
+		},
+		{
+			name: "slice of interfaces",
+			v:    []interface{}{int32(1), int32(2)},
+			exp:  types.ListValue(types.Int32Value(1), types.Int32Value(2)),
+		},
+		{
+			name: "map",
+			v:    map[string]int32{"b": 2, "a": 1},
+			exp: types.DictValue(
+				types.DictFieldValue(types.TextValue("a"), types.Int32Value(1)),
+				types.DictFieldValue(types.TextValue("b"), types.Int32Value(2)),
+			),
+		},
+		{
+			name: "empty map",
+			v:    map[string]float64{},
+			exp:  types.ZeroValue(types.Dict(types.TypeUTF8, types.TypeDouble)),
+		},
+		{
+			name: "struct",
+			v:    compositeSeries{ID: 1, Title: &title, Tags: []string{"b"}, Skipped: "c"},
+			exp: types.StructValue(
+				types.StructFieldValue("series_id", types.Uint64Value(1)),
+				types.StructFieldValue("Title", types.OptionalValue(types.TextValue("a"))),
+				types.StructFieldValue("tags", types.ListValue(types.TextValue("b"))),
+			),
+		},
+		{
+			name: "slice of structs",
+			v:    []compositeSeries{{ID: 1, Tags: []string{}}},
+			exp: types.ListValue(types.StructValue(
+				types.StructFieldValue("series_id", types.Uint64Value(1)),
+				types.StructFieldValue("Title", types.NullValue(types.TypeUTF8)),
+				types.StructFieldValue("tags", types.ZeroValue(types.List(types.TypeUTF8))),
+			)),
+		},
+		{
+			name: "primitive",
+			v:    int64(1),
+			exp:  types.Int64Value(1),
+		},
+		{
+			name: "different types of items",
+			v:    []interface{}{int32(1), "a"},
+			err:  true,
+		},
+		{
+			name: "empty slice of interfaces",
+			v:    []interface{}{},
+			err:  true,
+		},
+		{
+			name: "nil decimal",
+			v:    (*types.Decimal)(nil),
+			err:  true,
+		},
+		{
+			name: "unsupported type",
+			v:    []chan int{nil},
+			err:  true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := toValue(tt.v)
+			if tt.err {
+				if err == nil {
+					t.Fatalf("expected error, got %v", v)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if v.String() != tt.exp.String() {
+				t.Errorf("unexpected value: %s; want %s", v, tt.exp)
+			}
+		})
+	}
+}
+
+func TestScanComposite(t *testing.T) {
+	t.Run("slice", func(t *testing.T) {
+		var ids []compositeID
+		if err := ScanComposite(&ids).Scan([]int32{1, 2}); err != nil {
+			t.Fatal(err)
+		}
+		if len(ids) != 2 || ids[0] != 1 || ids[1] != 2 {
+			t.Errorf("unexpected ids: %v", ids)
+		}
+	})
+	t.Run("struct", func(t *testing.T) {
+		var series compositeSeries
+		err := ScanComposite(&series).Scan(map[string]interface{}{
+			"series_id": uint64(1),
+			"Title":     "a",
+			"tags":      []string{"b"},
+			"unknown":   1,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if series.ID != 1 || series.Title == nil || *series.Title != "a" || len(series.Tags) != 1 || series.Tags[0] != "b" {
+			t.Errorf("unexpected series: %+v", series)
+		}
+	})
+	t.Run("map", func(t *testing.T) {
+		var m map[string]*int
+		one := int64(1)
+		if err := ScanComposite(&m).Scan(map[string]*int64{"a": &one, "b": nil}); err != nil {
+			t.Fatal(err)
+		}
+		if len(m) != 2 || m["a"] == nil || *m["a"] != 1 || m["b"] != nil {
+			t.Errorf("unexpected map: %v", m)
+		}
+	})
+	t.Run("tuple", func(t *testing.T) {
+		var tuple []string
+		if err := ScanComposite(&tuple).Scan([]interface{}{"a", nil}); err != nil {
+			t.Fatal(err)
+		}
+		if len(tuple) != 2 || tuple[0] != "a" || tuple[1] != "" {
+			t.Errorf("unexpected tuple: %v", tuple)
+		}
+	})
+	t.Run("null", func(t *testing.T) {
+		ids := []int64{1}
+		if err := ScanComposite(&ids).Scan(nil); err != nil {
+			t.Fatal(err)
+		}
+		if ids != nil {
+			t.Errorf("unexpected ids: %v", ids)
+		}
+	})
+	t.Run("errors", func(t *testing.T) {
+		var ids []int64
+		for _, tt := range []struct {
+			dst interface{}
+			src interface{}
+		}{
+			{dst: ids, src: []int64{1}},
+			{dst: &ids, src: []string{"a"}},
+			{dst: &ids, src: int64(1)},
+		} {
+			if err := ScanComposite(tt.dst).Scan(tt.src); err == nil {
+				t.Errorf("expected error on scan %T into %T", tt.src, tt.dst)
+			}
+		}
+	})
+}
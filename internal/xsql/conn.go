@@ -43,6 +43,18 @@ func withDefaultQueryMode(mode QueryMode) connOption {
 	}
 }
 
+func withBindMode(mode BindMode) connOption {
+	return func(c *conn) {
+		c.bindMode = mode
+	}
+}
+
+func withRowsAffected(rowsAffected bool) connOption {
+	return func(c *conn) {
+		c.rowsAffected = rowsAffected
+	}
+}
+
 func withTrace(t trace.DatabaseSQL) connOption {
 	return func(c *conn) {
 		c.trace = t
@@ -56,6 +68,8 @@ type conn struct {
 
 	closed           uint32
 	defaultQueryMode QueryMode
+	bindMode         BindMode
+	rowsAffected     bool
 
 	defaultTxControl *table.TransactionControl
 	dataOpts         []options.ExecuteDataQueryOption
@@ -117,7 +131,20 @@ func (c *conn) setClosed() {
 	}
 }
 
-func (conn) CheckNamedValue(v *driver.NamedValue) (err error) {
+// dataQueryOptions returns default data query options of connector with options from context
+func (c *conn) dataQueryOptions(ctx context.Context) []options.ExecuteDataQueryOption {
+	return append(append([]options.ExecuteDataQueryOption{}, c.dataOpts...), dataQueryOptions(ctx)...)
+}
+
+// scanQueryOptions returns default scan query options of connector with options from context
+func (c *conn) scanQueryOptions(ctx context.Context) []options.ExecuteScanQueryOption {
+	return append(append([]options.ExecuteScanQueryOption{}, c.scanOpts...), scanQueryOptions(ctx)...)
+}
+
+func (c *conn) CheckNamedValue(v *driver.NamedValue) (err error) {
+	if c.bindMode == PositionalBind {
+		return checkPositionalValue(v)
+	}
 	return checkNamedValue(v)
 }
 
@@ -142,14 +169,22 @@ func (c *conn) execContext(ctx context.Context, query string, args []driver.Name
 	defer func() {
 		onDone(err)
 	}()
+	if m == BulkUpsertQueryMode {
+		return c.bulkUpsert(ctx, query, args)
+	}
+	var params *table.QueryParameters
+	params, err = toQueryParams(args)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
 	switch m {
 	case DataQueryMode:
 		var res result.Result
 		_, res, err = c.session.Execute(ctx,
 			txControl(ctx, c.defaultTxControl),
 			query,
-			toQueryParams(args),
-			dataQueryOptions(ctx)...,
+			params,
+			c.execQueryOptions(c.dataQueryOptions(ctx))...,
 		)
 		if err != nil {
 			return nil, c.checkClosed(xerrors.WithStackTrace(err))
@@ -157,7 +192,7 @@ func (c *conn) execContext(ctx context.Context, query string, args []driver.Name
 		if err = res.Err(); err != nil {
 			return nil, c.checkClosed(xerrors.WithStackTrace(err))
 		}
-		return driver.ResultNoRows, nil
+		return c.execResult(res), nil
 	case SchemeQueryMode:
 		err = c.session.ExecuteSchemeQuery(ctx, query)
 		if err != nil {
@@ -165,7 +200,7 @@ func (c *conn) execContext(ctx context.Context, query string, args []driver.Name
 		}
 		return driver.ResultNoRows, nil
 	case ScriptingQueryMode:
-		_, err = c.connector.connection.Scripting().StreamExecute(ctx, query, toQueryParams(args))
+		_, err = c.connector.connection.Scripting().StreamExecute(ctx, query, params)
 		if err != nil {
 			return nil, c.checkClosed(xerrors.WithStackTrace(err))
 		}
@@ -179,7 +214,24 @@ func (c *conn) ExecContext(ctx context.Context, query string, args []driver.Name
 	if c.isClosed() {
 		return nil, errClosedConn
 	}
+	ctx, query, err = applyHints(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	bulkUpsert := queryModeFromContext(ctx, c.defaultQueryMode) == BulkUpsertQueryMode
+	if c.bindMode == PositionalBind && !bulkUpsert {
+		query, args, err = bindPositional(query, args)
+		if err != nil {
+			return nil, err
+		}
+	}
 	if c.currentTx != nil {
+		if bulkUpsert {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("ydb: bulk upsert is not supported in transaction"))
+		}
+		if err = checkTxHint(ctx); err != nil {
+			return nil, err
+		}
 		return c.currentTx.ExecContext(ctx, query, args)
 	}
 	return c.execContext(ctx, query, args)
@@ -189,7 +241,20 @@ func (c *conn) QueryContext(ctx context.Context, query string, args []driver.Nam
 	if c.isClosed() {
 		return nil, errClosedConn
 	}
+	ctx, query, err = applyHints(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	if c.bindMode == PositionalBind {
+		query, args, err = bindPositional(query, args)
+		if err != nil {
+			return nil, err
+		}
+	}
 	if c.currentTx != nil {
+		if err = checkTxHint(ctx); err != nil {
+			return nil, err
+		}
 		return c.currentTx.QueryContext(ctx, query, args)
 	}
 	return c.queryContext(ctx, query, args)
@@ -201,14 +266,19 @@ func (c *conn) queryContext(ctx context.Context, query string, args []driver.Nam
 	defer func() {
 		onDone(err)
 	}()
+	var params *table.QueryParameters
+	params, err = toQueryParams(args)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
 	switch m {
 	case DataQueryMode:
 		var res result.Result
 		_, res, err = c.session.Execute(ctx,
 			txControl(ctx, c.defaultTxControl),
 			query,
-			toQueryParams(args),
-			dataQueryOptions(ctx)...,
+			params,
+			c.dataQueryOptions(ctx)...,
 		)
 		if err != nil {
 			return nil, c.checkClosed(xerrors.WithStackTrace(err))
@@ -224,8 +294,8 @@ func (c *conn) queryContext(ctx context.Context, query string, args []driver.Nam
 		var res result.StreamResult
 		res, err = c.session.StreamExecuteScanQuery(ctx,
 			query,
-			toQueryParams(args),
-			scanQueryOptions(ctx)...,
+			params,
+			c.scanQueryOptions(ctx)...,
 		)
 		if err != nil {
 			return nil, c.checkClosed(xerrors.WithStackTrace(err))
@@ -251,7 +321,7 @@ func (c *conn) queryContext(ctx context.Context, query string, args []driver.Nam
 		}, nil
 	case ScriptingQueryMode:
 		var res result.StreamResult
-		res, err = c.connector.connection.Scripting().StreamExecute(ctx, query, toQueryParams(args))
+		res, err = c.connector.connection.Scripting().StreamExecute(ctx, query, params)
 		if err != nil {
 			return nil, c.checkClosed(xerrors.WithStackTrace(err))
 		}
@@ -315,25 +385,28 @@ func (c *conn) BeginTx(ctx context.Context, txOptions driver.TxOptions) (_ drive
 			fmt.Errorf("conn already have an opened currentTx: %s", c.currentTx.ID()),
 		)
 	}
-	// TODO: replace with true transaction with snapshot read-only isolation after implementing it on server-side
-	//nolint:godox
-	if txOptions.ReadOnly && txOptions.Isolation == driver.IsolationLevel(sql.LevelSnapshot) {
+	mode, err := isolation.ToYDB(txOptions)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	if !mode.Interactive() {
 		c.currentTx = &fakeTx{
-			conn: c,
-			txControl: table.TxControl(
-				table.BeginTx(table.WithSerializableReadWrite()),
-				table.CommitTx(),
-			),
-			ctx: ctx,
+			conn:      c,
+			txControl: mode.TxControl(),
+			ctx:       ctx,
 		}
 		return c.currentTx, nil
 	}
-	var txc table.TxOption
-	txc, err = isolation.ToYDB(txOptions)
-	if err != nil {
-		return nil, xerrors.WithStackTrace(err)
+	if mode == isolation.SnapshotReadOnly && !c.connector.snapshotReadOnlySupported() {
+		mode = isolation.SerializableReadWrite
+	}
+	transaction, err = c.session.BeginTransaction(ctx, table.TxSettings(mode.TxOption()))
+	if err != nil && mode == isolation.SnapshotReadOnly && isTxModeUnsupported(err) {
+		// server not supports snapshot read-only transactions, serializable read-write transaction
+		// gives the same consistent view of data
+		c.connector.setSnapshotReadOnlyUnsupported()
+		transaction, err = c.session.BeginTransaction(ctx, table.TxSettings(table.WithSerializableReadWrite()))
 	}
-	transaction, err = c.session.BeginTransaction(ctx, table.TxSettings(txc))
 	if err != nil {
 		return nil, c.checkClosed(xerrors.WithStackTrace(err))
 	}
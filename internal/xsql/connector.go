@@ -4,6 +4,8 @@ import (
 	"context"
 	"database/sql/driver"
 	"io"
+	"sync/atomic"
+	"time"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/scripting"
@@ -42,6 +44,23 @@ func WithDefaultScanQueryOptions(opts ...options.ExecuteScanQueryOption) Connect
 	}
 }
 
+// WithRowsAffected enables counting of rows affected by ExecContext in data query mode.
+// Rows counts from basic query stats, so collecting of stats requests on each exec query
+func WithRowsAffected() ConnectorOption {
+	return func(c *Connector) error {
+		c.rowsAffected = true
+		return nil
+	}
+}
+
+// WithBindMode sets mode of binding database/sql args to YQL parameters
+func WithBindMode(mode BindMode) ConnectorOption {
+	return func(c *Connector) error {
+		c.bindMode = mode
+		return nil
+	}
+}
+
 func WithTrace(t trace.DatabaseSQL, opts ...trace.DatabaseSQLComposeOption) ConnectorOption {
 	return func(c *Connector) error {
 		c.trace = c.trace.Compose(t, opts...)
@@ -55,6 +74,7 @@ func Open(d Driver, connection connection, opts ...ConnectorOption) (_ *Connecto
 		connection:       connection,
 		defaultTxControl: table.DefaultTxControl(),
 		defaultQueryMode: DefaultQueryMode,
+		bindMode:         DefaultBindMode,
 	}
 	for _, opt := range opts {
 		if err = opt(c); err != nil {
@@ -66,6 +86,9 @@ func Open(d Driver, connection connection, opts ...ConnectorOption) (_ *Connecto
 }
 
 type connection interface {
+	// Name returns database name
+	Name() string
+
 	// Table returns table client
 	Table() table.Client
 
@@ -90,12 +113,22 @@ type Connector struct {
 
 	defaultTxControl     *table.TransactionControl
 	defaultQueryMode     QueryMode
+	bindMode             BindMode
+	rowsAffected         bool
 	defaultDataQueryOpts []options.ExecuteDataQueryOption
 	defaultScanQueryOpts []options.ExecuteScanQueryOption
 
 	trace trace.DatabaseSQL
+
+	// snapshotReadOnlyUnsupportedUntil is a unix time (in nanoseconds) until which
+	// read-only snapshot transactions begins as serializable read-write transactions
+	snapshotReadOnlyUnsupportedUntil int64
 }
 
+// snapshotReadOnlyRecheckInterval defines how long connector not tries snapshot read-only
+// transactions after server rejected this transaction mode
+const snapshotReadOnlyRecheckInterval = time.Minute
+
 var (
 	_ driver.Connector = &Connector{}
 	_ io.Closer        = &Connector{}
@@ -106,6 +139,14 @@ func (c *Connector) Close() (err error) {
 	return nil
 }
 
+func (c *Connector) snapshotReadOnlySupported() bool {
+	return time.Now().UnixNano() >= atomic.LoadInt64(&c.snapshotReadOnlyUnsupportedUntil)
+}
+
+func (c *Connector) setSnapshotReadOnlyUnsupported() {
+	atomic.StoreInt64(&c.snapshotReadOnlyUnsupportedUntil, time.Now().Add(snapshotReadOnlyRecheckInterval).UnixNano())
+}
+
 func (c *Connector) Connection() connection {
 	return c.connection
 }
@@ -122,6 +163,8 @@ func (c *Connector) Connect(ctx context.Context) (_ driver.Conn, err error) {
 	return newConn(c, s,
 		withDefaultTxControl(c.defaultTxControl),
 		withDefaultQueryMode(c.defaultQueryMode),
+		withBindMode(c.bindMode),
+		withRowsAffected(c.rowsAffected),
 		withDataOpts(c.defaultDataQueryOpts...),
 		withScanOpts(c.defaultScanQueryOpts...),
 		withTrace(c.trace),
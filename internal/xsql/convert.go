@@ -12,27 +12,39 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
 )
 
-func toQueryParams(values []driver.NamedValue) *table.QueryParameters {
+func toQueryParams(values []driver.NamedValue) (*table.QueryParameters, error) {
 	if len(values) == 0 {
-		return nil
+		return nil, nil
 	}
 	opts := make([]table.ParameterOption, len(values))
 	for i, arg := range values {
 		switch v := arg.Value.(type) {
 		case types.Value:
-			opts[i] = table.ValueParam(arg.Name, arg.Value.(types.Value))
+			if arg.Name == "" {
+				return nil, xerrors.WithStackTrace(internal.ErrNameRequired)
+			}
+			opts[i] = table.ValueParam(arg.Name, v)
 		case table.ParameterOption:
 			opts[i] = v
 		case *table.QueryParameters:
 			if len(values) != 1 {
-				panic("only one arg with type *table.QueryParameters are supported")
+				return nil, xerrors.WithStackTrace(
+					fmt.Errorf("ydb: only one arg with type *table.QueryParameters are supported"),
+				)
 			}
-			return v
+			return v, nil
 		default:
-			panic(fmt.Sprintf("unsupported type: %T", v))
+			if arg.Name == "" {
+				return nil, xerrors.WithStackTrace(internal.ErrNameRequired)
+			}
+			value, err := toValue(v)
+			if err != nil {
+				return nil, xerrors.WithStackTrace(err)
+			}
+			opts[i] = table.ValueParam(arg.Name, value)
 		}
 	}
-	return table.NewQueryParameters(opts...)
+	return table.NewQueryParameters(opts...), nil
 }
 
 //nolint:gocyclo
@@ -111,6 +123,13 @@ func primitiveToValue(v interface{}) (value types.Value, err error) {
 		return types.IntervalValueFromDuration(x), nil
 	case *time.Duration:
 		return types.NullableIntervalValueFromDuration(x), nil
+	case types.Decimal:
+		return types.DecimalValue(&x), nil
+	case *types.Decimal:
+		if x == nil {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("ydb: nil *types.Decimal has no precision and scale"))
+		}
+		return types.OptionalValue(types.DecimalValue(x)), nil
 	default:
 		return nil, xerrors.WithStackTrace(fmt.Errorf("ydb: unsupported type: %T", x))
 	}
@@ -123,12 +142,42 @@ func checkNamedValue(v *driver.NamedValue) (err error) {
 			return nil
 		case *table.QueryParameters:
 			return nil
+		case types.Value:
+			// unnamed value is a rows arg of bulk upsert query mode
+			return nil
 		default:
+			if isBulkUpsertRows(v.Value) {
+				return nil
+			}
 			return xerrors.WithStackTrace(internal.ErrNameRequired)
 		}
 	}
 
-	value, err := primitiveToValue(v.Value)
+	value, err := toValue(v.Value)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	v.Value = value
+
+	return nil
+}
+
+// checkPositionalValue converts unnamed args into YDB values as well as named args.
+// Unnamed args gets names on binding query placeholders
+func checkPositionalValue(v *driver.NamedValue) (err error) {
+	if v.Name != "" {
+		return checkNamedValue(v)
+	}
+	switch v.Value.(type) {
+	case table.ParameterOption, *table.QueryParameters:
+		return nil
+	}
+	if isBulkUpsertRows(v.Value) {
+		return nil
+	}
+
+	value, err := toValue(v.Value)
 	if err != nil {
 		return xerrors.WithStackTrace(err)
 	}
@@ -147,7 +196,7 @@ func GenerateDeclareSection(args []sql.NamedArg) (string, error) {
 		if arg.Name == "" {
 			return "", xerrors.WithStackTrace(internal.ErrNameRequired)
 		}
-		value, err := primitiveToValue(arg.Value)
+		value, err := toValue(arg.Value)
 		if err != nil {
 			return "", xerrors.WithStackTrace(err)
 		}
@@ -3,26 +3,105 @@ package xsql
 import (
 	"fmt"
 	"net/url"
+	"strconv"
+	"strings"
 
-	"github.com/ydb-platform/ydb-go-sdk/v3/config"
-	"github.com/ydb-platform/ydb-go-sdk/v3/credentials"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
 )
 
-func Parse(dataSourceName string) (opts []config.Option, connectorOpts []ConnectorOption, err error) {
+var (
+	// parsers maps params of connection string to options of database/sql connector
+	parsers = map[string]func(value string) (ConnectorOption, error){
+		"query_mode": func(value string) (ConnectorOption, error) {
+			mode := QueryModeFromString(value)
+			if mode == UnknownQueryMode {
+				return nil, fmt.Errorf("unknown query mode: %s", value)
+			}
+			return WithDefaultQueryMode(mode), nil
+		},
+		"bind_mode": func(value string) (ConnectorOption, error) {
+			mode, has := bindModes[value]
+			if !has {
+				return nil, fmt.Errorf("unknown bind mode: %s", value)
+			}
+			return WithBindMode(mode), nil
+		},
+		"tx_control": func(value string) (ConnectorOption, error) {
+			txControl, has := txControls[value]
+			if !has {
+				return nil, fmt.Errorf("unknown tx control: %s", value)
+			}
+			return WithDefaultTxControl(txControl()), nil
+		},
+		"rows_affected": func(value string) (ConnectorOption, error) {
+			rowsAffected, err := strconv.ParseBool(value)
+			if err != nil {
+				return nil, err
+			}
+			if !rowsAffected {
+				return func(c *Connector) error { return nil }, nil
+			}
+			return WithRowsAffected(), nil
+		},
+		"scan_query_stats": func(value string) (ConnectorOption, error) {
+			stats, has := scanQueryStats[value]
+			if !has {
+				return nil, fmt.Errorf("unknown scan query stats mode: %s", value)
+			}
+			return WithDefaultScanQueryOptions(options.WithExecuteScanQueryStats(stats)), nil
+		},
+	}
+	bindModes = map[string]BindMode{
+		"named":      NamedBind,
+		"positional": PositionalBind,
+	}
+	txControls = map[string]func() *table.TransactionControl{
+		"serializable_rw": func() *table.TransactionControl {
+			return table.SerializableReadWriteTxControl(table.CommitTx())
+		},
+		"snapshot_ro": table.SnapshotReadOnlyTxControl,
+		"online_ro": func() *table.TransactionControl {
+			return table.OnlineReadOnlyTxControl()
+		},
+		"online_ro_inconsistent": func() *table.TransactionControl {
+			return table.OnlineReadOnlyTxControl(table.WithInconsistentReads())
+		},
+		"stale_ro": table.StaleReadOnlyTxControl,
+	}
+	scanQueryStats = map[string]options.ExecuteScanQueryStatsType{
+		"none":  options.ExecuteScanQueryStatsTypeNone,
+		"basic": options.ExecuteScanQueryStatsTypeBasic,
+		"full":  options.ExecuteScanQueryStatsTypeFull,
+	}
+)
+
+// IsParam reports whether param of connection string configures database/sql connector
+func IsParam(param string) bool {
+	_, has := parsers[param]
+	return has
+}
+
+// Parse returns options of database/sql connector from params of connection string.
+// Params which not configures connector are ignored
+func Parse(dataSourceName string) (connectorOpts []ConnectorOption, err error) {
 	uri, err := url.Parse(dataSourceName)
 	if err != nil {
-		return nil, nil, xerrors.WithStackTrace(err)
+		return nil, xerrors.WithStackTrace(err)
 	}
-	if token := uri.Query().Get("token"); token != "" {
-		opts = append(opts, config.WithCredentials(credentials.NewAccessTokenCredentials(token)))
-	}
-	if queryMode := uri.Query().Get("query_mode"); queryMode != "" {
-		mode := QueryModeFromString(queryMode)
-		if mode == UnknownQueryMode {
-			return nil, nil, xerrors.WithStackTrace(fmt.Errorf("unknown query mode: %s", queryMode))
+	for param, values := range uri.Query() {
+		p, has := parsers[param]
+		if !has {
+			continue
+		}
+		for _, v := range values {
+			opt, err := p(strings.TrimSpace(v))
+			if err != nil {
+				return nil, xerrors.WithStackTrace(fmt.Errorf("wrong param '%s': %w", param, err))
+			}
+			connectorOpts = append(connectorOpts, opt)
 		}
-		connectorOpts = append(connectorOpts, WithDefaultQueryMode(mode))
 	}
-	return opts, connectorOpts, nil
+	return connectorOpts, nil
 }
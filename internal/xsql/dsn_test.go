@@ -0,0 +1,44 @@
+package xsql
+
+import (
+	"testing"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+)
+
+func TestParse(t *testing.T) {
+	opts, err := Parse("grpc://localhost:2135/local?" +
+		"query_mode=scripting&bind_mode=positional&tx_control=online_ro&scan_query_stats=basic&token=abc",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := &Connector{}
+	for _, opt := range opts {
+		if err = opt(c); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if c.defaultQueryMode != ScriptingQueryMode {
+		t.Errorf("unexpected query mode: %v", c.defaultQueryMode)
+	}
+	if c.bindMode != PositionalBind {
+		t.Errorf("unexpected bind mode: %v", c.bindMode)
+	}
+	if c.defaultTxControl.Desc().String() != table.OnlineReadOnlyTxControl().Desc().String() {
+		t.Errorf("unexpected tx control: %v", c.defaultTxControl.Desc())
+	}
+	if len(c.defaultScanQueryOpts) != 1 {
+		t.Errorf("unexpected scan query options: %v", c.defaultScanQueryOpts)
+	}
+	for _, dsn := range []string{
+		"grpc://localhost:2135/local?query_mode=unknown",
+		"grpc://localhost:2135/local?bind_mode=unknown",
+		"grpc://localhost:2135/local?tx_control=unknown",
+		"grpc://localhost:2135/local?scan_query_stats=unknown",
+	} {
+		if _, err = Parse(dsn); err == nil {
+			t.Errorf("expected error on parse %q", dsn)
+		}
+	}
+}
@@ -3,6 +3,9 @@ package xsql
 import (
 	"database/sql/driver"
 	"errors"
+	"strings"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql/badconn"
@@ -13,3 +16,17 @@ var (
 	errDeprecated  = driver.ErrSkip
 	errClosedConn  = badconn.Map(xerrors.Retryable(errors.New("conn closed early"), xerrors.WithDeleteSession()))
 )
+
+// isTxModeUnsupported reports whether err is a BAD_REQUEST of server which not knows requested
+// transaction mode (such as old servers responds on snapshot read-only transactions)
+func isTxModeUnsupported(err error) (unsupported bool) {
+	if !xerrors.IsOperationError(err, Ydb.StatusIds_BAD_REQUEST) {
+		return false
+	}
+	xerrors.IterateByIssues(err, func(message string, code Ydb.StatusIds_StatusCode, severity uint32) {
+		if strings.Contains(strings.ToLower(message), "unknown transaction mode") {
+			unsupported = true
+		}
+	})
+	return unsupported
+}
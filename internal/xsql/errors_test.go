@@ -0,0 +1,86 @@
+package xsql
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Issue"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+func TestIsTxModeUnsupported(t *testing.T) {
+	for _, tt := range []struct {
+		name        string
+		err         error
+		unsupported bool
+	}{
+		{
+			name: "unknown transaction mode",
+			err: xerrors.Operation(
+				xerrors.WithStatusCode(Ydb.StatusIds_BAD_REQUEST),
+				xerrors.WithIssues([]*Ydb_Issue.IssueMessage{{
+					Message: "Unknown transaction mode: 0",
+				}}),
+			),
+			unsupported: true,
+		},
+		{
+			name: "nested issue",
+			err: xerrors.WithStackTrace(xerrors.Operation(
+				xerrors.WithStatusCode(Ydb.StatusIds_BAD_REQUEST),
+				xerrors.WithIssues([]*Ydb_Issue.IssueMessage{{
+					Message: "bad request",
+					Issues: []*Ydb_Issue.IssueMessage{{
+						Message: "Unknown transaction mode: 0",
+					}},
+				}}),
+			)),
+			unsupported: true,
+		},
+		{
+			name: "other bad request",
+			err: xerrors.Operation(
+				xerrors.WithStatusCode(Ydb.StatusIds_BAD_REQUEST),
+				xerrors.WithIssues([]*Ydb_Issue.IssueMessage{{
+					Message: "Request has no session id",
+				}}),
+			),
+		},
+		{
+			name: "other status",
+			err: xerrors.Operation(
+				xerrors.WithStatusCode(Ydb.StatusIds_UNAVAILABLE),
+				xerrors.WithIssues([]*Ydb_Issue.IssueMessage{{
+					Message: "Unknown transaction mode: 0",
+				}}),
+			),
+		},
+		{
+			name: "not operation error",
+			err:  errors.New("unknown transaction mode"),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isTxModeUnsupported(tt.err); got != tt.unsupported {
+				t.Errorf("unexpected result: %v, exp: %v", got, tt.unsupported)
+			}
+		})
+	}
+}
+
+func TestConnectorSnapshotReadOnlySupported(t *testing.T) {
+	c := &Connector{}
+	if !c.snapshotReadOnlySupported() {
+		t.Fatal("snapshot read-only must be supported by default")
+	}
+	c.setSnapshotReadOnlyUnsupported()
+	if c.snapshotReadOnlySupported() {
+		t.Fatal("snapshot read-only must be unsupported after server rejection")
+	}
+	c.snapshotReadOnlyUnsupportedUntil -= int64(snapshotReadOnlyRecheckInterval)
+	if !c.snapshotReadOnlySupported() {
+		t.Fatal("snapshot read-only must be checked again after recheck interval")
+	}
+}
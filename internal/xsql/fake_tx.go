@@ -1,8 +1,5 @@
 package xsql
 
-// TODO: drop this after implementing it on server-side
-//nolint:godox
-
 import (
 	"context"
 	"database/sql/driver"
@@ -12,6 +9,8 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
+// fakeTx applies transaction control to each query of database/sql transaction.
+// YDB not allows to begin transactions with online and stale read-only modes
 type fakeTx struct {
 	txControl *table.TransactionControl
 	conn      *conn
@@ -0,0 +1,135 @@
+package xsql
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+)
+
+// hintPrefix is a prefix of leading query comments with driver hints
+const hintPrefix = "ydb:"
+
+type ctxTxHintKey struct{}
+
+// txHint returns value of tx hint of query (such as online_ro) if query has tx hint
+func txHint(ctx context.Context) (value string, has bool) {
+	value, has = ctx.Value(ctxTxHintKey{}).(string)
+	return value, has
+}
+
+// hints maps keys of query hints to context modifiers
+var hints = map[string]func(ctx context.Context, value string) (context.Context, error){
+	"mode": func(ctx context.Context, value string) (context.Context, error) {
+		mode := QueryModeFromString(value)
+		if mode == UnknownQueryMode {
+			return nil, fmt.Errorf("unknown query mode: %s", value)
+		}
+		return WithQueryMode(ctx, mode), nil
+	},
+	"tx": func(ctx context.Context, value string) (context.Context, error) {
+		txControl, has := txControls[value]
+		if !has {
+			return nil, fmt.Errorf("unknown tx control: %s", value)
+		}
+		return context.WithValue(WithTxControl(ctx, txControl()), ctxTxHintKey{}, value), nil
+	},
+	"keep_in_cache": func(ctx context.Context, value string) (context.Context, error) {
+		keepInCache := true
+		if value != "" {
+			var err error
+			if keepInCache, err = strconv.ParseBool(value); err != nil {
+				return nil, err
+			}
+		}
+		return WithDataQueryOptions(ctx, options.WithKeepInCache(keepInCache)), nil
+	},
+}
+
+// applyHints applies hints from leading comments of query such as
+//
+//	-- ydb:mode=scan
+//	/* ydb:tx=online_ro keep_in_cache */
+//
+// to context and returns query without comments with hints.
+// Hints overrides defaults of connector and options from context.
+// Other comments are left intact
+func applyHints(ctx context.Context, query string) (_ context.Context, _ string, err error) {
+	var (
+		kept     strings.Builder
+		stripped bool
+		start    int
+		i        int
+	)
+	for {
+		start = i
+		for i < len(query) && isSpace(query[i]) {
+			i++
+		}
+		var body string
+		if strings.HasPrefix(query[i:], "--") {
+			end := strings.IndexByte(query[i:], '\n')
+			if end < 0 {
+				end = len(query) - i
+			} else {
+				end++
+			}
+			body, i = query[i+2:i+end], i+end
+		} else if strings.HasPrefix(query[i:], "/*") && strings.Contains(query[i+2:], "*/") {
+			end := strings.Index(query[i+2:], "*/")
+			body, i = query[i+2:i+2+end], i+2+end+2
+		} else {
+			break
+		}
+		body = strings.TrimSpace(body)
+		if !strings.HasPrefix(body, hintPrefix) {
+			kept.WriteString(query[start:i])
+			continue
+		}
+		ctx, err = applyHint(ctx, body[len(hintPrefix):])
+		if err != nil {
+			return ctx, query, xerrors.WithStackTrace(fmt.Errorf("ydb: wrong query hint '%s': %w", body, err))
+		}
+		stripped = true
+	}
+	if !stripped {
+		return ctx, query, nil
+	}
+	kept.WriteString(query[start:])
+	return ctx, kept.String(), nil
+}
+
+func applyHint(ctx context.Context, hint string) (_ context.Context, err error) {
+	for _, field := range strings.FieldsFunc(hint, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\t'
+	}) {
+		key, value := field, ""
+		if j := strings.IndexByte(field, '='); j >= 0 {
+			key, value = field[:j], field[j+1:]
+		}
+		apply, has := hints[key]
+		if !has {
+			return ctx, fmt.Errorf("unknown hint key: %s", key)
+		}
+		if ctx, err = apply(ctx, value); err != nil {
+			return ctx, err
+		}
+	}
+	return ctx, nil
+}
+
+// checkTxHint returns error if query in transaction has tx hint: transaction mode
+// defined on begin of transaction and cannot be changed by query
+func checkTxHint(ctx context.Context) error {
+	if value, has := txHint(ctx); has {
+		return xerrors.WithStackTrace(fmt.Errorf("ydb: query hint 'tx=%s' is not supported in transaction", value))
+	}
+	return nil
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
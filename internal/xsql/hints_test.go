@@ -0,0 +1,116 @@
+package xsql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+)
+
+func TestApplyHints(t *testing.T) {
+	for _, tt := range []struct {
+		query       string
+		exp         string
+		mode        QueryMode
+		txControl   *table.TransactionControl
+		dataOptions int
+		err         bool
+	}{
+		{
+			query: "SELECT 1",
+			exp:   "SELECT 1",
+			mode:  DataQueryMode,
+		},
+		{
+			query: "-- ydb:mode=scan\nSELECT 1",
+			exp:   "SELECT 1",
+			mode:  ScanQueryMode,
+		},
+		{
+			query:     "/* ydb:tx=online_ro */ SELECT 1",
+			exp:       " SELECT 1",
+			mode:      DataQueryMode,
+			txControl: table.OnlineReadOnlyTxControl(),
+		},
+		{
+			query:       "-- ydb:keep_in_cache\n/* ydb:mode=scripting, tx=stale_ro */\nSELECT 1",
+			exp:         "\nSELECT 1",
+			mode:        ScriptingQueryMode,
+			txControl:   table.StaleReadOnlyTxControl(),
+			dataOptions: 1,
+		},
+		{
+			query:       "-- some comment\n-- ydb:keep_in_cache=false\n/* other */ SELECT 1 -- ydb:mode=scan",
+			exp:         "-- some comment\n/* other */ SELECT 1 -- ydb:mode=scan",
+			mode:        DataQueryMode,
+			dataOptions: 1,
+		},
+		{
+			query: "-- ydb:mode=scan",
+			exp:   "",
+			mode:  ScanQueryMode,
+		},
+		{
+			query: "/*/ ydb:mode=scan */ SELECT 1",
+			exp:   "/*/ ydb:mode=scan */ SELECT 1",
+			mode:  DataQueryMode,
+		},
+		{
+			query: "/* ydb:mode=scan SELECT 1",
+			exp:   "/* ydb:mode=scan SELECT 1",
+			mode:  DataQueryMode,
+		},
+		{
+			query: "-- ydb:mode=unknown\nSELECT 1",
+			err:   true,
+		},
+		{
+			query: "-- ydb:tx=unknown\nSELECT 1",
+			err:   true,
+		},
+		{
+			query: "-- ydb:keep_in_cache=maybe\nSELECT 1",
+			err:   true,
+		},
+		{
+			query: "-- ydb:unknown=1\nSELECT 1",
+			err:   true,
+		},
+	} {
+		t.Run(tt.query, func(t *testing.T) {
+			ctx, query, err := applyHints(context.Background(), tt.query)
+			if tt.err {
+				if err == nil {
+					t.Fatalf("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if query != tt.exp {
+				t.Errorf("unexpected query: %q; want %q", query, tt.exp)
+			}
+			if mode := queryModeFromContext(ctx, DataQueryMode); mode != tt.mode {
+				t.Errorf("unexpected query mode: %s; want %s", mode, tt.mode)
+			}
+			if txc := txControl(ctx, nil); txc.Desc().String() != tt.txControl.Desc().String() {
+				t.Errorf("unexpected tx control: %v; want %v", txc.Desc(), tt.txControl.Desc())
+			}
+			if opts := dataQueryOptions(ctx); len(opts) != tt.dataOptions {
+				t.Errorf("unexpected data query options count: %d; want %d", len(opts), tt.dataOptions)
+			}
+		})
+	}
+}
+
+func TestTxHintInTransaction(t *testing.T) {
+	c := &conn{currentTx: &fakeTx{}}
+	query := "-- ydb:tx=online_ro\nSELECT 1"
+	if _, err := c.QueryContext(context.Background(), query, nil); err == nil {
+		t.Error("expected error of tx hint in transaction query")
+	}
+	if _, err := c.ExecContext(context.Background(), query, nil); err == nil {
+		t.Error("expected error of tx hint in transaction exec")
+	}
+}
@@ -9,16 +9,97 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/table"
 )
 
-// ToYDB maps driver transaction options to ydb transaction Option or query transaction control.
-// This caused by ydb logic that prevents start actual transaction with OnlineReadOnly mode and ReadCommitted
-// and ReadUncommitted isolation levels should use tx_control in every query request.
-// It returns error on unsupported options.
-func ToYDB(opts driver.TxOptions) (txcControl table.TxOption, err error) {
+// LevelStaleReadOnly is an extension of database/sql isolation levels for stale read-only transactions.
+// It must be used with read-only flag of sql.TxOptions
+const LevelStaleReadOnly = sql.IsolationLevel(100)
+
+// Mode is a YDB transaction mode of database/sql transaction
+type Mode int
+
+const (
+	SerializableReadWrite = Mode(iota)
+	SnapshotReadOnly
+	OnlineReadOnly
+	OnlineReadOnlyInconsistentReads
+	StaleReadOnly
+)
+
+func (m Mode) String() string {
+	switch m {
+	case SerializableReadWrite:
+		return "serializable_rw"
+	case SnapshotReadOnly:
+		return "snapshot_ro"
+	case OnlineReadOnly:
+		return "online_ro"
+	case OnlineReadOnlyInconsistentReads:
+		return "online_ro_inconsistent"
+	case StaleReadOnly:
+		return "stale_ro"
+	default:
+		return fmt.Sprintf("unknown_mode_%d", int(m))
+	}
+}
+
+// Interactive reports whether transaction with mode m is backed by YDB transaction.
+// YDB not allows to begin transactions with online and stale read-only modes,
+// so they apply transaction control to each query in database/sql transaction
+func (m Mode) Interactive() bool {
+	return m == SerializableReadWrite || m == SnapshotReadOnly
+}
+
+// TxOption returns YDB transaction settings option of mode m
+func (m Mode) TxOption() table.TxOption {
+	switch m {
+	case SnapshotReadOnly:
+		return table.WithSnapshotReadOnly()
+	case OnlineReadOnly:
+		return table.WithOnlineReadOnly()
+	case OnlineReadOnlyInconsistentReads:
+		return table.WithOnlineReadOnly(table.WithInconsistentReads())
+	case StaleReadOnly:
+		return table.WithStaleReadOnly()
+	default:
+		return table.WithSerializableReadWrite()
+	}
+}
+
+// TxControl returns transaction control of single query with mode m
+func (m Mode) TxControl() *table.TransactionControl {
+	return table.TxControl(table.BeginTx(m.TxOption()), table.CommitTx())
+}
+
+// ToYDB maps driver transaction options to YDB transaction mode:
+//
+//   - read-write with sql.LevelDefault or sql.LevelSerializable - SerializableReadWrite
+//   - read-only with sql.LevelDefault, sql.LevelSnapshot or sql.LevelRepeatableRead - SnapshotReadOnly
+//   - read-only with sql.LevelReadCommitted - OnlineReadOnly
+//   - read-only with sql.LevelReadUncommitted - OnlineReadOnly with inconsistent reads
+//   - read-only with LevelStaleReadOnly - StaleReadOnly
+//
+// It returns error on other (unsupported) options, such as read-only with sql.LevelSerializable:
+// YDB has no serializable read-only transactions.
+func ToYDB(opts driver.TxOptions) (mode Mode, err error) {
 	level := sql.IsolationLevel(opts.Isolation)
-	if !opts.ReadOnly && level == sql.LevelDefault {
-		return table.WithSerializableReadWrite(), nil
+	if !opts.ReadOnly {
+		switch level {
+		case sql.LevelDefault, sql.LevelSerializable:
+			return SerializableReadWrite, nil
+		}
+	} else {
+		switch level {
+		case sql.LevelDefault, sql.LevelSnapshot, sql.LevelRepeatableRead:
+			return SnapshotReadOnly, nil
+		case sql.LevelReadCommitted:
+			return OnlineReadOnly, nil
+		case sql.LevelReadUncommitted:
+			return OnlineReadOnlyInconsistentReads, nil
+		case LevelStaleReadOnly:
+			return StaleReadOnly, nil
+		}
 	}
-	return nil, xerrors.WithStackTrace(fmt.Errorf(
-		"ydb: unsupported transaction options: %+v", opts,
+	return 0, xerrors.WithStackTrace(fmt.Errorf(
+		"ydb: unsupported transaction options: isolation level '%s', read-only %v",
+		level, opts.ReadOnly,
 	))
 }
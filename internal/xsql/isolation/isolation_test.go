@@ -15,7 +15,7 @@ import (
 func TestToYDB(t *testing.T) {
 	for _, tt := range []struct {
 		txOptions driver.TxOptions
-		txControl table.TxOption
+		mode      Mode
 		err       bool
 	}{
 		// read-write
@@ -24,8 +24,7 @@ func TestToYDB(t *testing.T) {
 				Isolation: driver.IsolationLevel(sql.LevelDefault),
 				ReadOnly:  false,
 			},
-			txControl: table.WithSerializableReadWrite(),
-			err:       false,
+			mode: SerializableReadWrite,
 		},
 		{
 			txOptions: driver.TxOptions{
@@ -67,7 +66,7 @@ func TestToYDB(t *testing.T) {
 				Isolation: driver.IsolationLevel(sql.LevelSerializable),
 				ReadOnly:  false,
 			},
-			err: true,
+			mode: SerializableReadWrite,
 		},
 		{
 			txOptions: driver.TxOptions{
@@ -77,27 +76,35 @@ func TestToYDB(t *testing.T) {
 			err: true,
 		},
 
+		{
+			txOptions: driver.TxOptions{
+				Isolation: driver.IsolationLevel(LevelStaleReadOnly),
+				ReadOnly:  false,
+			},
+			err: true,
+		},
+
 		// read-only
 		{
 			txOptions: driver.TxOptions{
 				Isolation: driver.IsolationLevel(sql.LevelDefault),
 				ReadOnly:  true,
 			},
-			err: true,
+			mode: SnapshotReadOnly,
 		},
 		{
 			txOptions: driver.TxOptions{
 				Isolation: driver.IsolationLevel(sql.LevelReadUncommitted),
 				ReadOnly:  true,
 			},
-			err: true,
+			mode: OnlineReadOnlyInconsistentReads,
 		},
 		{
 			txOptions: driver.TxOptions{
 				Isolation: driver.IsolationLevel(sql.LevelReadCommitted),
 				ReadOnly:  true,
 			},
-			err: true,
+			mode: OnlineReadOnly,
 		},
 		{
 			txOptions: driver.TxOptions{
@@ -111,14 +118,14 @@ func TestToYDB(t *testing.T) {
 				Isolation: driver.IsolationLevel(sql.LevelRepeatableRead),
 				ReadOnly:  true,
 			},
-			err: true,
+			mode: SnapshotReadOnly,
 		},
 		{
 			txOptions: driver.TxOptions{
 				Isolation: driver.IsolationLevel(sql.LevelSnapshot),
 				ReadOnly:  true,
 			},
-			err: true,
+			mode: SnapshotReadOnly,
 		},
 		{
 			txOptions: driver.TxOptions{
@@ -134,17 +141,60 @@ func TestToYDB(t *testing.T) {
 			},
 			err: true,
 		},
+		{
+			txOptions: driver.TxOptions{
+				Isolation: driver.IsolationLevel(LevelStaleReadOnly),
+				ReadOnly:  true,
+			},
+			mode: StaleReadOnly,
+		},
 	} {
 		t.Run(fmt.Sprintf("%+v", tt.txOptions), func(t *testing.T) {
-			toYDB, err := ToYDB(tt.txOptions)
+			mode, err := ToYDB(tt.txOptions)
 			if !tt.err {
 				require.NoError(t, err)
-				if !proto.Equal(table.TxSettings(tt.txControl).Settings(), table.TxSettings(toYDB).Settings()) {
-					t.Errorf("%+v != %+v", toYDB, tt.txControl)
-				}
+				require.Equal(t, tt.mode, mode)
 			} else {
 				require.Error(t, err)
 			}
 		})
 	}
 }
+
+func TestModeTxOption(t *testing.T) {
+	for _, tt := range []struct {
+		mode        Mode
+		txOption    table.TxOption
+		interactive bool
+	}{
+		{
+			mode:        SerializableReadWrite,
+			txOption:    table.WithSerializableReadWrite(),
+			interactive: true,
+		},
+		{
+			mode:        SnapshotReadOnly,
+			txOption:    table.WithSnapshotReadOnly(),
+			interactive: true,
+		},
+		{
+			mode:     OnlineReadOnly,
+			txOption: table.WithOnlineReadOnly(),
+		},
+		{
+			mode:     OnlineReadOnlyInconsistentReads,
+			txOption: table.WithOnlineReadOnly(table.WithInconsistentReads()),
+		},
+		{
+			mode:     StaleReadOnly,
+			txOption: table.WithStaleReadOnly(),
+		},
+	} {
+		t.Run(tt.mode.String(), func(t *testing.T) {
+			require.Equal(t, tt.interactive, tt.mode.Interactive())
+			if !proto.Equal(table.TxSettings(tt.txOption).Settings(), table.TxSettings(tt.mode.TxOption()).Settings()) {
+				t.Errorf("%+v != %+v", table.TxSettings(tt.mode.TxOption()).Settings(), table.TxSettings(tt.txOption).Settings())
+			}
+		})
+	}
+}
@@ -11,24 +11,27 @@ const (
 	ScanQueryMode
 	SchemeQueryMode
 	ScriptingQueryMode
+	BulkUpsertQueryMode
 
 	DefaultQueryMode = DataQueryMode
 )
 
 var (
 	typeToString = map[QueryMode]string{
-		DataQueryMode:      "data",
-		ScanQueryMode:      "scan",
-		ExplainQueryMode:   "explain",
-		SchemeQueryMode:    "scheme",
-		ScriptingQueryMode: "scripting",
+		DataQueryMode:       "data",
+		ScanQueryMode:       "scan",
+		ExplainQueryMode:    "explain",
+		SchemeQueryMode:     "scheme",
+		ScriptingQueryMode:  "scripting",
+		BulkUpsertQueryMode: "bulk_upsert",
 	}
 	stringToType = map[string]QueryMode{
-		"data":      DataQueryMode,
-		"scan":      ScanQueryMode,
-		"explain":   ExplainQueryMode,
-		"scheme":    SchemeQueryMode,
-		"scripting": ScriptingQueryMode,
+		"data":        DataQueryMode,
+		"scan":        ScanQueryMode,
+		"explain":     ExplainQueryMode,
+		"scheme":      SchemeQueryMode,
+		"scripting":   ScriptingQueryMode,
+		"bulk_upsert": BulkUpsertQueryMode,
 	}
 )
 
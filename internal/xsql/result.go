@@ -0,0 +1,55 @@
+package xsql
+
+import (
+	"database/sql/driver"
+	"path"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/stats"
+)
+
+// execQueryOptions returns data query options of exec query.
+// Basic query stats requests for counting of affected rows if connector configured with WithRowsAffected
+func (c *conn) execQueryOptions(opts []options.ExecuteDataQueryOption) []options.ExecuteDataQueryOption {
+	if !c.rowsAffected {
+		return opts
+	}
+	return append([]options.ExecuteDataQueryOption{options.WithCollectStatsModeBasic()}, opts...)
+}
+
+// execResult returns result of exec query with count of affected rows from query stats
+func (c *conn) execResult(res result.Result) driver.Result {
+	if !c.rowsAffected {
+		return driver.ResultNoRows
+	}
+	return driver.RowsAffected(rowsAffected(res.Stats()))
+}
+
+// indexImplTable is a name of implementation table of secondary index
+const indexImplTable = "indexImplTable"
+
+// rowsAffected returns count of rows updated (including inserted) and deleted by query
+// from table access stats of query phases.
+// Accesses to implementation tables of secondary indexes are not counted
+func rowsAffected(s stats.QueryStats) (n int64) {
+	if s == nil {
+		return 0
+	}
+	for {
+		phase, ok := s.NextPhase()
+		if !ok {
+			return n
+		}
+		for {
+			t, ok := phase.NextTableAccess()
+			if !ok {
+				break
+			}
+			if path.Base(t.Name) == indexImplTable {
+				continue
+			}
+			n += int64(t.Updates.Rows + t.Deletes.Rows)
+		}
+	}
+}
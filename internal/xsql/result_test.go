@@ -0,0 +1,65 @@
+package xsql
+
+import (
+	"database/sql/driver"
+	"testing"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_TableStats"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/scanner"
+)
+
+func TestExecResult(t *testing.T) {
+	res := scanner.NewUnary(nil, &Ydb_TableStats.QueryStats{
+		QueryPhases: []*Ydb_TableStats.QueryPhaseStats{
+			{
+				TableAccess: []*Ydb_TableStats.TableAccessStats{
+					{
+						Name:  "/local/a",
+						Reads: &Ydb_TableStats.OperationStats{Rows: 10},
+					},
+				},
+			},
+			{
+				TableAccess: []*Ydb_TableStats.TableAccessStats{
+					{
+						Name:    "/local/a",
+						Updates: &Ydb_TableStats.OperationStats{Rows: 3},
+					},
+					{
+						Name:    "/local/b",
+						Updates: &Ydb_TableStats.OperationStats{Rows: 1},
+						Deletes: &Ydb_TableStats.OperationStats{Rows: 2},
+					},
+					{
+						Name:    "/local/b/idx_value/indexImplTable",
+						Updates: &Ydb_TableStats.OperationStats{Rows: 1},
+						Deletes: &Ydb_TableStats.OperationStats{Rows: 1},
+					},
+				},
+			},
+		},
+	})
+	c := &conn{}
+	if r := c.execResult(res); r != driver.ResultNoRows {
+		t.Errorf("unexpected result without rows affected option: %v", r)
+	}
+	c.rowsAffected = true
+	n, err := c.execResult(res).RowsAffected()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 6 {
+		t.Errorf("unexpected rows affected: %d", n)
+	}
+	n, err = c.execResult(scanner.NewUnary(nil, nil)).RowsAffected()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 0 {
+		t.Errorf("unexpected rows affected without stats: %d", n)
+	}
+	if opts := c.execQueryOptions(nil); len(opts) != 1 {
+		t.Errorf("unexpected exec query options: %v", opts)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"database/sql/driver"
 	"io"
+	"reflect"
 	"sync"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
@@ -15,9 +16,13 @@ import (
 )
 
 var (
-	_ driver.Rows              = &rows{}
-	_ driver.RowsNextResultSet = &rows{}
-	_ driver.Rows              = &single{}
+	_ driver.Rows                           = &rows{}
+	_ driver.RowsNextResultSet              = &rows{}
+	_ driver.RowsColumnTypeDatabaseTypeName = &rows{}
+	_ driver.RowsColumnTypeNullable         = &rows{}
+	_ driver.RowsColumnTypeScanType         = &rows{}
+	_ driver.RowsColumnTypePrecisionScale   = &rows{}
+	_ driver.Rows                           = &single{}
 
 	_ types.Scanner = &valuer{}
 )
@@ -44,6 +49,37 @@ func (r *rows) Columns() []string {
 	return cs
 }
 
+// columnType returns type of column with index in current result set
+func (r *rows) columnType(index int) (t types.Type) {
+	r.nextSet.Do(func() {
+		r.result.NextResultSet(context.Background())
+	})
+	var i int
+	r.result.CurrentResultSet().Columns(func(m options.Column) {
+		if i == index {
+			t = m.Type
+		}
+		i++
+	})
+	return t
+}
+
+func (r *rows) ColumnTypeDatabaseTypeName(index int) string {
+	return columnTypeDatabaseTypeName(r.columnType(index))
+}
+
+func (r *rows) ColumnTypeNullable(index int) (nullable, ok bool) {
+	return columnTypeNullable(r.columnType(index))
+}
+
+func (r *rows) ColumnTypeScanType(index int) reflect.Type {
+	return columnTypeScanType(r.columnType(index))
+}
+
+func (r *rows) ColumnTypePrecisionScale(index int) (precision, scale int64, ok bool) {
+	return columnTypePrecisionScale(r.columnType(index))
+}
+
 func (r *rows) NextResultSet() error {
 	r.nextSet.Do(func() {})
 	return r.result.NextResultSetErr(context.Background())
@@ -29,9 +29,13 @@ func (s *stmt) QueryContext(ctx context.Context, args []driver.NamedValue) (_ dr
 	if s.conn.isClosed() {
 		return nil, errClosedConn
 	}
+	ctx, query, err := applyHints(withKeepInCache(ctx), s.query)
+	if err != nil {
+		return nil, err
+	}
 	switch m := queryModeFromContext(ctx, s.conn.defaultQueryMode); m {
 	case DataQueryMode:
-		return s.conn.QueryContext(withKeepInCache(ctx), s.query, args)
+		return s.conn.QueryContext(ctx, query, args)
 	default:
 		return nil, fmt.Errorf("unsupported query mode '%s' for execute query on prepared statement", m)
 	}
@@ -45,9 +49,13 @@ func (s *stmt) ExecContext(ctx context.Context, args []driver.NamedValue) (_ dri
 	if s.conn.isClosed() {
 		return nil, errClosedConn
 	}
+	ctx, query, err := applyHints(withKeepInCache(ctx), s.query)
+	if err != nil {
+		return nil, err
+	}
 	switch m := queryModeFromContext(ctx, s.conn.defaultQueryMode); m {
 	case DataQueryMode:
-		return s.conn.ExecContext(withKeepInCache(ctx), s.query, args)
+		return s.conn.ExecContext(ctx, query, args)
 	default:
 		return nil, fmt.Errorf("unsupported query mode '%s' for execute query on prepared statement", m)
 	}
@@ -69,11 +69,16 @@ func (tx *tx) QueryContext(ctx context.Context, query string, args []driver.Name
 	defer func() {
 		onDone(err)
 	}()
+	var params *table.QueryParameters
+	params, err = toQueryParams(args)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
 	var res result.Result
 	res, err = tx.tx.Execute(ctx,
 		query,
-		toQueryParams(args),
-		dataQueryOptions(ctx)...,
+		params,
+		tx.conn.dataQueryOptions(ctx)...,
 	)
 	if err != nil {
 		return nil, tx.conn.checkClosed(xerrors.WithStackTrace(err))
@@ -92,13 +97,22 @@ func (tx *tx) ExecContext(ctx context.Context, query string, args []driver.Named
 	defer func() {
 		onDone(err)
 	}()
-	_, err = tx.tx.Execute(ctx,
+	var params *table.QueryParameters
+	params, err = toQueryParams(args)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	var res result.Result
+	res, err = tx.tx.Execute(ctx,
 		query,
-		toQueryParams(args),
-		dataQueryOptions(ctx)...,
+		params,
+		tx.conn.execQueryOptions(tx.conn.dataQueryOptions(ctx))...,
 	)
 	if err != nil {
 		return nil, tx.conn.checkClosed(xerrors.WithStackTrace(err))
 	}
-	return driver.ResultNoRows, nil
+	if err = res.Err(); err != nil {
+		return nil, tx.conn.checkClosed(xerrors.WithStackTrace(err))
+	}
+	return tx.conn.execResult(res), nil
 }
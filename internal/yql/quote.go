@@ -0,0 +1,11 @@
+package yql
+
+import "strings"
+
+var identifierReplacer = strings.NewReplacer("\\", "\\\\", "`", "\\`")
+
+// QuoteIdentifier quotes name of column, index or table path with backticks.
+// Backslashes and backticks inside name are escaped
+func QuoteIdentifier(name string) string {
+	return "`" + identifierReplacer.Replace(name) + "`"
+}
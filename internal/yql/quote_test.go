@@ -0,0 +1,22 @@
+package yql
+
+import "testing"
+
+func TestQuoteIdentifier(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		exp  string
+	}{
+		{name: "id", exp: "`id`"},
+		{name: "/local/series", exp: "`/local/series`"},
+		{name: "a`b", exp: "`a\\`b`"},
+		{name: "a\\", exp: "`a\\\\`"},
+		{name: "a\\`", exp: "`a\\\\\\``"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := QuoteIdentifier(tt.name); got != tt.exp {
+				t.Errorf("unexpected quoted identifier: %s, want: %s", got, tt.exp)
+			}
+		})
+	}
+}
@@ -11,10 +11,28 @@ import (
 
 // Retry returns trace.Retry with logging events from details
 func Retry(l Logger, details trace.Details) (t trace.Retry) {
-	if details&trace.RetryEvents == 0 {
+	if details&(trace.RetryEvents|trace.RetryDeniedEvents) == 0 {
 		return
 	}
 	l = l.WithName(`retry`)
+	if details&trace.RetryDeniedEvents != 0 {
+		t.OnRetryDenied = func(info trace.RetryDeniedInfo) {
+			f := l.Warnf
+			if !xerrors.IsYdb(info.Error) {
+				f = l.Debugf
+			}
+			f(`retry denied {id:"%s",attempts:%v,error:"%s",reason:"%s",version:"%s"}`,
+				info.ID,
+				info.Attempts,
+				info.Error,
+				info.Reason,
+				meta.Version,
+			)
+		}
+	}
+	if details&trace.RetryEvents == 0 {
+		return t
+	}
 	t.OnRetry = func(
 		info trace.RetryLoopStartInfo,
 	) func(
@@ -673,6 +673,41 @@ func Table(l Logger, details trace.Details, opts ...option) (t trace.Table) {
 					info.Event,
 				)
 			}
+			t.OnPoolNodesUpdate = func(info trace.TablePoolNodesUpdateInfo) {
+				l.Infof(`nodes updated {nodes:%v,added:%v,removed:%v}`,
+					info.Nodes,
+					info.Added,
+					info.Removed,
+				)
+			}
+			t.OnPoolRebalance = func(info trace.TablePoolRebalanceStartInfo) func(trace.TablePoolRebalanceDoneInfo) {
+				session := info.Session
+				from, to := info.FromNodeID, info.ToNodeID
+				l.Debugf(`rebalance start {id:"%s",from:%d,to:%d}`,
+					session.ID(),
+					from,
+					to,
+				)
+				start := time.Now()
+				return func(info trace.TablePoolRebalanceDoneInfo) {
+					if info.Error == nil {
+						l.Infof(`rebalance done {latency:"%v",from:%d,to:%d,id:"%s"}`,
+							time.Since(start),
+							from,
+							to,
+							info.Session.ID(),
+						)
+					} else {
+						l.Warnf(`rebalance failed {latency:"%v",from:%d,to:%d,error:"%v",version:"%s"}`,
+							time.Since(start),
+							from,
+							to,
+							info.Error,
+							meta.Version,
+						)
+					}
+				}
+			}
 		}
 		if details&trace.TablePoolSessionLifeCycleEvents != 0 {
 			//nolint:govet
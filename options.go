@@ -26,6 +26,7 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql"
 	"github.com/ydb-platform/ydb-go-sdk/v3/log"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
 	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicoptions"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
@@ -93,6 +94,17 @@ func WithConnectionString(connectionString string) Option {
 		)
 		c.options = append(c.options, info.Options...)
 		c.userInfo = info.UserInfo
+		opts, err := dsnOptions(info.Params)
+		if err != nil {
+			return xerrors.WithStackTrace(
+				fmt.Errorf("parse connection string '%s' failed: %w", connectionString, err),
+			)
+		}
+		for _, opt := range opts {
+			if err = opt(ctx, c); err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+		}
 		return nil
 	}
 }
@@ -350,6 +362,34 @@ func WithSessionPoolSizeLimit(sizeLimit int) Option {
 	}
 }
 
+// WithSessionPoolMinIdleSize set target count of idle sessions in table.Client.
+// Table client creates idle sessions eagerly at startup and keeps up its count in the background.
+// Idle sessions older than idle threshold are closed only above this count.
+// Use table.Warmer for waiting until pool is ready
+func WithSessionPoolMinIdleSize(minIdleSize int) Option {
+	return func(ctx context.Context, c *connection) error {
+		c.tableOptions = append(c.tableOptions, tableConfig.WithMinIdleSize(minIdleSize))
+		return nil
+	}
+}
+
+// WithSessionPoolSessionsPerNodeLimit set max count of sessions on each YDB node in table.Client
+func WithSessionPoolSessionsPerNodeLimit(sessionsPerNodeLimit int) Option {
+	return func(ctx context.Context, c *connection) error {
+		c.tableOptions = append(c.tableOptions, tableConfig.WithSessionsPerNodeLimit(sessionsPerNodeLimit))
+		return nil
+	}
+}
+
+// WithSessionPoolRebalanceInterval set interval of rotating idle sessions toward underloaded
+// (such as newly discovered) YDB nodes in table.Client
+func WithSessionPoolRebalanceInterval(rebalanceInterval time.Duration) Option {
+	return func(ctx context.Context, c *connection) error {
+		c.tableOptions = append(c.tableOptions, tableConfig.WithRebalanceInterval(rebalanceInterval))
+		return nil
+	}
+}
+
 // WithSessionPoolKeepAliveMinSize set minimum sessions should be keeped alive in table.Client
 //
 // Deprecated: table client do not supports background session keep-aliving now
@@ -386,6 +426,27 @@ func WithSessionPoolDeleteTimeout(deleteTimeout time.Duration) Option {
 	}
 }
 
+// WithRetryBudget limits retries of table.Client calls (Do and DoTx) with shared retry budget.
+// Use retry.NewBudget for making retry budget with (optional) circuit breaker
+func WithRetryBudget(budget *retry.Budget) Option {
+	return func(ctx context.Context, c *connection) error {
+		c.tableOptions = append(c.tableOptions, tableConfig.WithRetryBudget(budget))
+		return nil
+	}
+}
+
+// WithRetryPolicy replaces default retry policy of table.Client calls (Do and DoTx).
+// Use retry.NewPolicy for making policy with limits of retries or custom retryable errors.
+// table.WithRetryPolicy option redefines policy for single call.
+// Backoffs from table.WithFastBackoff and table.WithSlowBackoff options of call override
+// fast and slow backoffs of this policy
+func WithRetryPolicy(policy retry.Policy) Option {
+	return func(ctx context.Context, c *connection) error {
+		c.tableOptions = append(c.tableOptions, tableConfig.WithRetryPolicy(policy))
+		return nil
+	}
+}
+
 // WithIgnoreTruncated disables errors on truncated flag
 func WithIgnoreTruncated() Option {
 	return func(ctx context.Context, c *connection) error {
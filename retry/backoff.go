@@ -6,8 +6,21 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
 )
 
+// BackoffStrategy defines delays between attempts of retry.
+// Custom implementations may be used in retry options and returned from Policy.Backoff
+type BackoffStrategy interface {
+	// Wait maps index of the retry to a channel which fulfillment means that delay is over.
+	// Retry index begins from 0 and 0-th index means the first retry attempt after an initial error
+	Wait(n int) <-chan time.Time
+
+	// Delay returns delay of i-th retry
+	Delay(i int) time.Duration
+}
+
+var _ BackoffStrategy = backoff.Backoff(nil)
+
 // Backoff makes backoff object with custom params
-func Backoff(slotDuration time.Duration, ceiling uint, jitterLimit float64) backoff.Backoff {
+func Backoff(slotDuration time.Duration, ceiling uint, jitterLimit float64) BackoffStrategy {
 	return backoff.New(
 		backoff.WithSlotDuration(slotDuration),
 		backoff.WithCeiling(ceiling),
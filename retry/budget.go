@@ -0,0 +1,200 @@
+package retry
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+const (
+	// DefaultBudgetRatio is a default share of successful calls which may be retried
+	DefaultBudgetRatio = 0.1
+
+	// DefaultBudgetCapacity is a default count of retries which budget allows without successful calls
+	DefaultBudgetCapacity = 100
+)
+
+var (
+	// ErrBudgetExhausted is a reason of denied retry if retry budget has no tokens
+	ErrBudgetExhausted = errors.New("retry budget exhausted")
+
+	// ErrCircuitOpen is a reason of denied retry if circuit breaker of error class is open
+	ErrCircuitOpen = errors.New("circuit breaker is open")
+)
+
+// Budget limits retries of calls which share it. Budget is a token bucket with capacity
+// tokens: each retry takes one token and each successful call returns ratio of token,
+// so retries are limited to ratio of successful calls (after burst of capacity retries).
+//
+// Budget with circuit breaker also denies retries of error class (such as OVERLOADED or
+// Unavailable) after threshold retryable failures of this class in a row, until timeout is elapsed.
+// Successes of calls which not failed with this class not break the row.
+//
+// Budget is safe for concurrent use. Share single budget between calls with WithBudget option
+// or with table client config
+type Budget struct {
+	ratio    float64
+	capacity float64
+	breaker  *breaker
+	trace    trace.Retry
+	clock    clockwork.Clock
+
+	mu     sync.Mutex
+	tokens float64
+}
+
+// BudgetOption is an option of retry budget
+type BudgetOption func(b *Budget)
+
+// WithBudgetRatio defines share of successful calls which may be retried
+func WithBudgetRatio(ratio float64) BudgetOption {
+	return func(b *Budget) {
+		if ratio >= 0 {
+			b.ratio = ratio
+		}
+	}
+}
+
+// WithBudgetCapacity defines max count of retries which budget allows without successful calls
+func WithBudgetCapacity(capacity int) BudgetOption {
+	return func(b *Budget) {
+		if capacity > 0 {
+			b.capacity = float64(capacity)
+		}
+	}
+}
+
+// WithCircuitBreaker enables circuit breaker which denies retries of error class for timeout
+// after threshold retryable failures of this class in a row. Call which succeeded after failure
+// of error class resets failures counter of this class only
+func WithCircuitBreaker(threshold int, timeout time.Duration) BudgetOption {
+	return func(b *Budget) {
+		if threshold > 0 && timeout > 0 {
+			b.breaker = &breaker{
+				threshold: threshold,
+				timeout:   timeout,
+				failures:  make(map[string]int),
+				openUntil: make(map[string]time.Time),
+			}
+		}
+	}
+}
+
+// WithBudgetTrace appends retry trace of denied retries of all calls which share budget
+func WithBudgetTrace(t trace.Retry, opts ...trace.RetryComposeOption) BudgetOption {
+	return func(b *Budget) {
+		b.trace = b.trace.Compose(t, opts...)
+	}
+}
+
+func withBudgetClock(clock clockwork.Clock) BudgetOption {
+	return func(b *Budget) {
+		b.clock = clock
+	}
+}
+
+// NewBudget makes retry budget with DefaultBudgetRatio and DefaultBudgetCapacity
+// (if not redefined with options) and without circuit breaker
+func NewBudget(opts ...BudgetOption) *Budget {
+	b := &Budget{
+		ratio:    DefaultBudgetRatio,
+		capacity: DefaultBudgetCapacity,
+		clock:    clockwork.NewRealClock(),
+	}
+	for _, o := range opts {
+		o(b)
+	}
+	b.tokens = b.capacity
+	return b
+}
+
+// success returns ratio of token into budget after successful call.
+// Failures counter of circuit breaker resets only for class of error which call failed with
+// before success (empty class means that call succeeded at first attempt)
+func (b *Budget) success(class string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.tokens += b.ratio; b.tokens > b.capacity {
+		b.tokens = b.capacity
+	}
+	if b.breaker != nil && class != "" {
+		b.breaker.reset(class)
+	}
+}
+
+// acquire takes token for retry of call failed with error of class.
+// Returns reason of denied retry or nil if retry allowed
+func (b *Budget) acquire(class string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.breaker != nil && !b.breaker.fail(class, b.clock.Now()) {
+		return ErrCircuitOpen
+	}
+	if b.tokens < 1 {
+		return ErrBudgetExhausted
+	}
+	b.tokens--
+	return nil
+}
+
+type breaker struct {
+	threshold int
+	timeout   time.Duration
+	failures  map[string]int
+	openUntil map[string]time.Time
+}
+
+// fail registers failure of error class and reports whether retries of error class are allowed
+func (br *breaker) fail(class string, now time.Time) bool {
+	if until, has := br.openUntil[class]; has {
+		if now.Before(until) {
+			return false
+		}
+		delete(br.openUntil, class)
+	}
+	br.failures[class]++
+	if br.failures[class] >= br.threshold {
+		br.failures[class] = 0
+		br.openUntil[class] = now.Add(br.timeout)
+		return false
+	}
+	return true
+}
+
+func (br *breaker) reset(class string) {
+	delete(br.failures, class)
+}
+
+// errorClass returns class of error for default retry policy
+func errorClass(err error) string {
+	var e xerrors.Error
+	if xerrors.As(err, &e) {
+		return e.Name()
+	}
+	return "unknown"
+}
+
+// deniedError is an error of call with denied retry.
+// It unwraps to error of last attempt and matches reason of denied retry with errors.Is
+type deniedError struct {
+	err    error
+	reason error
+}
+
+func (e *deniedError) Error() string {
+	return fmt.Sprintf("%v (retry denied: %v)", e.err, e.reason)
+}
+
+func (e *deniedError) Unwrap() error {
+	return e.err
+}
+
+func (e *deniedError) Is(target error) bool {
+	return target == e.reason
+}
@@ -0,0 +1,118 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+func TestBudget(t *testing.T) {
+	b := NewBudget(WithBudgetCapacity(2), WithBudgetRatio(0.5))
+	for i := 0; i < 2; i++ {
+		if err := b.acquire("A"); err != nil {
+			t.Fatalf("unexpected denied retry %d: %v", i, err)
+		}
+	}
+	if err := b.acquire("A"); !errors.Is(err, ErrBudgetExhausted) {
+		t.Fatalf("unexpected reason: %v", err)
+	}
+	b.success("")
+	if err := b.acquire("A"); !errors.Is(err, ErrBudgetExhausted) {
+		t.Fatalf("unexpected reason: %v", err)
+	}
+	b.success("")
+	b.success("")
+	if err := b.acquire("A"); err != nil {
+		t.Fatalf("unexpected denied retry: %v", err)
+	}
+	for i := 0; i < 10; i++ {
+		b.success("")
+	}
+	if b.tokens != b.capacity {
+		t.Fatalf("unexpected tokens: %v", b.tokens)
+	}
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+	b := NewBudget(WithCircuitBreaker(2, time.Second), withBudgetClock(clock))
+	if err := b.acquire("A"); err != nil {
+		t.Fatalf("unexpected denied retry: %v", err)
+	}
+	if err := b.acquire("A"); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("unexpected reason: %v", err)
+	}
+	if err := b.acquire("B"); err != nil {
+		t.Fatalf("unexpected denied retry of other error class: %v", err)
+	}
+	b.success("B")
+	if err := b.acquire("A"); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("circuit breaker closed before timeout: %v", err)
+	}
+	clock.Advance(time.Second)
+	if err := b.acquire("A"); err != nil {
+		t.Fatalf("unexpected denied retry after timeout: %v", err)
+	}
+	b.success("A")
+	if err := b.acquire("A"); err != nil {
+		t.Fatalf("unexpected denied retry after success: %v", err)
+	}
+}
+
+func TestCircuitBreakerInterleavedSuccesses(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+	b := NewBudget(WithCircuitBreaker(3, time.Second), withBudgetClock(clock))
+	for i := 0; i < 2; i++ {
+		if err := b.acquire("A"); err != nil {
+			t.Fatalf("unexpected denied retry %d: %v", i, err)
+		}
+		// successes of other calls not reset failures of error class A
+		b.success("")
+		b.success("B")
+	}
+	if err := b.acquire("A"); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("circuit breaker not opened with interleaved successes: %v", err)
+	}
+}
+
+func TestRetryWithBudget(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	var denied []trace.RetryDeniedInfo
+	b := NewBudget(WithBudgetCapacity(3), WithBudgetTrace(trace.Retry{
+		OnRetryDenied: func(info trace.RetryDeniedInfo) {
+			denied = append(denied, info)
+		},
+	}))
+	calls := 0
+	errRetryable := xerrors.Retryable(errors.New("test"), xerrors.WithBackoff(backoff.TypeNoBackoff))
+	err := Retry(ctx, func(ctx context.Context) error {
+		calls++
+		return errRetryable
+	}, WithBudget(b), WithID("test"))
+	if !errors.Is(err, ErrBudgetExhausted) || !errors.Is(err, errRetryable) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls != 4 {
+		t.Fatalf("unexpected calls: %d", calls)
+	}
+	if len(denied) != 1 || denied[0].ID != "test" || denied[0].Attempts != 4 || denied[0].Reason != ErrBudgetExhausted {
+		t.Fatalf("unexpected denied events: %+v", denied)
+	}
+	err = Retry(ctx, func(ctx context.Context) error {
+		return nil
+	}, WithBudget(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b.tokens != DefaultBudgetRatio {
+		t.Fatalf("unexpected tokens: %v", b.tokens)
+	}
+}
@@ -0,0 +1,45 @@
+package retry_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
+)
+
+// constantBackoff delays each retry for the same duration
+type constantBackoff time.Duration
+
+func (b constantBackoff) Wait(n int) <-chan time.Time {
+	return time.After(b.Delay(n))
+}
+
+func (b constantBackoff) Delay(int) time.Duration {
+	return time.Duration(b)
+}
+
+// constantBackoffPolicy redefines backoff of default retry policy
+type constantBackoffPolicy struct {
+	retry.Policy
+	backoff retry.BackoffStrategy
+}
+
+func (p constantBackoffPolicy) Backoff(err error) retry.BackoffStrategy {
+	return p.backoff
+}
+
+func ExamplePolicy_backoff() {
+	policy := constantBackoffPolicy{
+		Policy:  retry.NewPolicy(retry.WithMaxAttempts(3)),
+		backoff: constantBackoff(time.Millisecond),
+	}
+	attempts := 0
+	err := retry.Retry(context.TODO(), func(ctx context.Context) error {
+		attempts++
+		return retry.RetryableError(errors.New("not ready yet"))
+	}, retry.WithPolicy(policy))
+	fmt.Println(attempts, errors.Is(err, retry.ErrMaxAttempts))
+	// Output: 3 true
+}
@@ -0,0 +1,142 @@
+package retry
+
+import (
+	"errors"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
+)
+
+var (
+	// ErrMaxAttempts is a reason of denied retry if call made max attempts of retry policy
+	ErrMaxAttempts = errors.New("max attempts exceeded")
+
+	// ErrMaxElapsed is a reason of denied retry if call elapsed max time of retry policy
+	ErrMaxElapsed = errors.New("max elapsed time exceeded")
+)
+
+// Policy defines which failed calls are retried, backoff between attempts and limits of retries.
+//
+// Policy from NewPolicy without options is a default policy. Custom policy may embed it
+// and redefine some methods (for example, Backoff for OVERLOADED errors)
+type Policy interface {
+	// Retryable reports whether call failed with err must be retried.
+	// Non-idempotent calls must be retried only if err guarantees that operation was not applied
+	Retryable(err error, idempotent bool) bool
+
+	// Class returns class of err such as name of YDB status.
+	// Backoff index resets on change of error class between attempts and
+	// circuit breaker of retry budget counts failures of each class separately
+	Class(err error) string
+
+	// Backoff returns backoff of retry of call failed with err.
+	// Nil backoff means retry without delay
+	Backoff(err error) BackoffStrategy
+
+	// Limits returns max count of attempts of call and max elapsed time of call (since first attempt).
+	// Zero values mean no limits
+	Limits() (maxAttempts int, maxElapsed time.Duration)
+}
+
+type policy struct {
+	fastBackoff     BackoffStrategy
+	slowBackoff     BackoffStrategy
+	retryableErrors []error
+	maxAttempts     int
+	maxElapsed      time.Duration
+}
+
+// PolicyOption is an option of retry policy
+type PolicyOption func(p *policy)
+
+// WithPolicyFastBackoff replaces default fast backoff of retry policy
+func WithPolicyFastBackoff(b BackoffStrategy) PolicyOption {
+	return func(p *policy) {
+		if b != nil {
+			p.fastBackoff = b
+		}
+	}
+}
+
+// WithPolicySlowBackoff replaces default slow backoff of retry policy
+func WithPolicySlowBackoff(b BackoffStrategy) PolicyOption {
+	return func(p *policy) {
+		if b != nil {
+			p.slowBackoff = b
+		}
+	}
+}
+
+// WithRetryableErrors makes errors retryable regardless of idempotency of call.
+// Failed call retries with fast backoff if errors.Is matches error of call with one of errs
+func WithRetryableErrors(errs ...error) PolicyOption {
+	return func(p *policy) {
+		p.retryableErrors = append(p.retryableErrors, errs...)
+	}
+}
+
+// WithMaxAttempts limits count of attempts of call (including first attempt)
+func WithMaxAttempts(n int) PolicyOption {
+	return func(p *policy) {
+		if n > 0 {
+			p.maxAttempts = n
+		}
+	}
+}
+
+// WithMaxElapsed limits time of call with retries: no attempts are made after d since first attempt
+func WithMaxElapsed(d time.Duration) PolicyOption {
+	return func(p *policy) {
+		if d > 0 {
+			p.maxElapsed = d
+		}
+	}
+}
+
+// NewPolicy makes retry policy which classifies errors with Check and
+// retries calls with fast or slow backoff without limits (if not redefined with options)
+func NewPolicy(opts ...PolicyOption) Policy {
+	p := &policy{
+		fastBackoff: backoff.Fast,
+		slowBackoff: backoff.Slow,
+	}
+	for _, o := range opts {
+		o(p)
+	}
+	return p
+}
+
+func (p *policy) isRetryableError(err error) bool {
+	for _, e := range p.retryableErrors {
+		if errors.Is(err, e) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *policy) Retryable(err error, idempotent bool) bool {
+	return p.isRetryableError(err) || Check(err).MustRetry(idempotent)
+}
+
+func (p *policy) Class(err error) string {
+	return errorClass(err)
+}
+
+func (p *policy) Backoff(err error) BackoffStrategy {
+	if p.isRetryableError(err) {
+		return p.fastBackoff
+	}
+	switch Check(err).BackoffType() {
+	case backoff.TypeFast:
+		return p.fastBackoff
+	case backoff.TypeSlow:
+		return p.slowBackoff
+	default:
+		return nil
+	}
+}
+
+func (p *policy) Limits() (maxAttempts int, maxElapsed time.Duration) {
+	return p.maxAttempts, p.maxElapsed
+}
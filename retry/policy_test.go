@@ -0,0 +1,104 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+var errSentinel = errors.New("sentinel")
+
+// conservativePolicy retries OVERLOADED errors with custom backoff and default policy otherwise
+type conservativePolicy struct {
+	Policy
+	overloaded BackoffStrategy
+}
+
+func (p conservativePolicy) Backoff(err error) BackoffStrategy {
+	if xerrors.IsOperationError(err, Ydb.StatusIds_OVERLOADED) {
+		return p.overloaded
+	}
+	return p.Policy.Backoff(err)
+}
+
+func TestPolicy(t *testing.T) {
+	fast := backoff.New(backoff.WithSlotDuration(time.Nanosecond))
+	for _, tt := range []struct {
+		name     string
+		policy   Policy
+		err      error
+		attempts int
+		reason   error
+	}{
+		{
+			name:     "default",
+			policy:   NewPolicy(WithPolicyFastBackoff(fast)),
+			err:      errSentinel,
+			attempts: 1,
+		},
+		{
+			name:     "retryable errors",
+			policy:   NewPolicy(WithPolicyFastBackoff(fast), WithRetryableErrors(errSentinel), WithMaxAttempts(3)),
+			err:      errSentinel,
+			attempts: 3,
+			reason:   ErrMaxAttempts,
+		},
+		{
+			name:     "max attempts",
+			policy:   NewPolicy(WithPolicyFastBackoff(fast), WithMaxAttempts(5)),
+			err:      xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_UNAVAILABLE)),
+			attempts: 5,
+			reason:   ErrMaxAttempts,
+		},
+		{
+			name: "custom backoff",
+			policy: conservativePolicy{
+				Policy:     NewPolicy(WithMaxAttempts(2)),
+				overloaded: fast,
+			},
+			err:      xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_OVERLOADED)),
+			attempts: 2,
+			reason:   ErrMaxAttempts,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := 0
+			err := Retry(context.Background(), func(ctx context.Context) error {
+				attempts++
+				return tt.err
+			}, WithIdempotent(true), WithPolicy(tt.policy))
+			if attempts != tt.attempts {
+				t.Errorf("unexpected attempts: %d, want: %d", attempts, tt.attempts)
+			}
+			if !errors.Is(err, tt.err) {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if tt.reason != nil && !errors.Is(err, tt.reason) {
+				t.Errorf("unexpected reason of denied retry: %v", err)
+			}
+		})
+	}
+}
+
+func TestPolicyMaxElapsed(t *testing.T) {
+	attempts := 0
+	err := Retry(context.Background(), func(ctx context.Context) error {
+		attempts++
+		return xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_UNAVAILABLE))
+	}, WithIdempotent(true), WithPolicy(NewPolicy(
+		WithPolicyFastBackoff(backoff.New(backoff.WithSlotDuration(10*time.Millisecond), backoff.WithJitterLimit(1))),
+		WithMaxElapsed(50*time.Millisecond),
+	)))
+	if !errors.Is(err, ErrMaxElapsed) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if attempts < 2 || attempts > 4 {
+		t.Fatalf("unexpected attempts: %d", attempts)
+	}
+}
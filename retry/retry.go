@@ -2,10 +2,10 @@ package retry
 
 import (
 	"context"
+	"time"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/retry"
-	"github.com/ydb-platform/ydb-go-sdk/v3/internal/wait"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
@@ -20,8 +20,10 @@ type retryOptions struct {
 	trace       trace.Retry
 	idempotent  bool
 	stackTrace  bool
-	fastBackoff backoff.Backoff
-	slowBackoff backoff.Backoff
+	fastBackoff BackoffStrategy
+	slowBackoff BackoffStrategy
+	budget      *Budget
+	policy      Policy
 
 	panicCallback func(e interface{})
 }
@@ -57,19 +59,37 @@ func WithIdempotent(idempotent bool) retryOption {
 }
 
 // WithFastBackoff replaces default fast backoff
-func WithFastBackoff(b backoff.Backoff) retryOption {
+func WithFastBackoff(b BackoffStrategy) retryOption {
 	return func(o *retryOptions) {
 		o.fastBackoff = b
 	}
 }
 
 // WithSlowBackoff replaces default slow backoff
-func WithSlowBackoff(b backoff.Backoff) retryOption {
+func WithSlowBackoff(b BackoffStrategy) retryOption {
 	return func(o *retryOptions) {
 		o.slowBackoff = b
 	}
 }
 
+// WithBudget limits retries with shared retry budget (and circuit breaker of budget).
+// Call returns error of last attempt if retry denied
+func WithBudget(b *Budget) retryOption {
+	return func(o *retryOptions) {
+		o.budget = b
+	}
+}
+
+// WithPolicy replaces default retry policy (see NewPolicy) of call.
+// Backoffs from WithFastBackoff and WithSlowBackoff options are not used with custom policy
+func WithPolicy(p Policy) retryOption {
+	return func(o *retryOptions) {
+		if p != nil {
+			o.policy = p
+		}
+	}
+}
+
 // WithPanicCallback returns panic callback option
 // If not defined - panic would not intercept with driver
 func WithPanicCallback(panicCallback func(e interface{})) retryOption {
@@ -97,6 +117,12 @@ func Retry(ctx context.Context, op retryOperation, opts ...retryOption) (err err
 	for _, o := range opts {
 		o(options)
 	}
+	if options.policy == nil {
+		options.policy = NewPolicy(
+			WithPolicyFastBackoff(options.fastBackoff),
+			WithPolicySlowBackoff(options.slowBackoff),
+		)
+	}
 	ctx = retry.WithIdempotent(ctx, options.idempotent)
 	defer func() {
 		if err != nil && options.stackTrace {
@@ -110,12 +136,23 @@ func Retry(ctx context.Context, op retryOperation, opts ...retryOption) (err err
 		i        int
 		attempts int
 
-		code           = int64(0)
+		class          string
+		start          = time.Now()
 		onIntermediate = trace.RetryOnRetry(options.trace, &ctx, options.id, options.idempotent)
+
+		maxAttempts, maxElapsed = options.policy.Limits()
 	)
 	defer func() {
 		onIntermediate(err)(attempts, err)
 	}()
+	deny := func(err, reason error) error {
+		t := options.trace
+		if options.budget != nil {
+			t = t.Compose(options.budget.trace)
+		}
+		trace.RetryOnRetryDenied(t, &ctx, options.id, attempts, err, reason)
+		return xerrors.WithStackTrace(&deniedError{err: err, reason: reason})
+	}
 	for {
 		i++
 		attempts++
@@ -136,30 +173,60 @@ func Retry(ctx context.Context, op retryOperation, opts ...retryOption) (err err
 			}()
 
 			if err == nil {
+				if options.budget != nil {
+					options.budget.success(class)
+				}
 				return
 			}
 
-			m := Check(err)
-
-			if m.StatusCode() != code {
-				i = 0
+			if c := options.policy.Class(err); c != class {
+				i, class = 0, c
 			}
 
-			if !m.MustRetry(options.idempotent) {
+			if !options.policy.Retryable(err, options.idempotent) {
 				return xerrors.WithStackTrace(err)
 			}
 
-			if e := wait.Wait(ctx, options.fastBackoff, options.slowBackoff, m.BackoffType(), i); e != nil {
-				return xerrors.WithStackTrace(err)
+			if maxAttempts > 0 && attempts >= maxAttempts {
+				return deny(err, ErrMaxAttempts)
+			}
+
+			if maxElapsed > 0 && time.Since(start) >= maxElapsed {
+				return deny(err, ErrMaxElapsed)
+			}
+
+			if options.budget != nil {
+				if reason := options.budget.acquire(class); reason != nil {
+					return deny(err, reason)
+				}
 			}
 
-			code = m.StatusCode()
+			if b := options.policy.Backoff(err); b != nil {
+				if e := waitBackoff(ctx, b, i); e != nil {
+					return xerrors.WithStackTrace(err)
+				}
+			}
+
+			if maxElapsed > 0 && time.Since(start) >= maxElapsed {
+				return deny(err, ErrMaxElapsed)
+			}
 
 			onIntermediate(err)
 		}
 	}
 }
 
+// waitBackoff waits for i-th backoff b or ctx expiration.
+// It returns non-nil error if and only if ctx expiration branch wins
+func waitBackoff(ctx context.Context, b BackoffStrategy, i int) error {
+	select {
+	case <-b.Wait(i):
+		return nil
+	case <-ctx.Done():
+		return xerrors.WithStackTrace(ctx.Err())
+	}
+}
+
 // Check returns retry mode for queryErr.
 func Check(err error) (m retry.Mode) {
 	statusCode, operationStatus, backoff, deleteSession := retry.Check(err)
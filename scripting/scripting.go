@@ -5,6 +5,7 @@ import (
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/closer"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/result"
 )
 
@@ -37,3 +38,22 @@ type Client interface {
 		params *table.QueryParameters,
 	) (result.StreamResult, error)
 }
+
+// ClientWithOptions is an optional interface of Client for executing of scripts
+// with data query options (such as options.WithAutoDeclare)
+//
+// Client returned from ydb.Connection.Scripting() implements ClientWithOptions
+type ClientWithOptions interface {
+	ExecuteWithOptions(
+		ctx context.Context,
+		query string,
+		params *table.QueryParameters,
+		opts ...options.ExecuteDataQueryOption,
+	) (result.Result, error)
+	StreamExecuteWithOptions(
+		ctx context.Context,
+		query string,
+		params *table.QueryParameters,
+		opts ...options.ExecuteDataQueryOption,
+	) (result.StreamResult, error)
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql/isolation"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsync"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
@@ -54,11 +55,11 @@ func (d *sqlDriver) Open(string) (driver.Conn, error) {
 }
 
 func (d *sqlDriver) OpenConnector(dataSourceName string) (driver.Connector, error) {
-	opts, connectorOpts, err := xsql.Parse(dataSourceName)
+	connectorOpts, err := xsql.Parse(dataSourceName)
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
 	}
-	db, err := Open(context.Background(), dataSourceName, With(opts...))
+	db, err := Open(context.Background(), dataSourceName)
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
 	}
@@ -85,6 +86,10 @@ const (
 	ScanQueryMode      = xsql.ScanQueryMode
 	SchemeQueryMode    = xsql.SchemeQueryMode
 	ScriptingQueryMode = xsql.ScriptingQueryMode
+
+	// BulkUpsertQueryMode routes ExecContext to BulkUpsert of table with path from query text.
+	// Single arg must be a slice of structs or a list value of rows
+	BulkUpsertQueryMode = xsql.BulkUpsertQueryMode
 )
 
 func WithQueryMode(ctx context.Context, mode QueryMode) context.Context {
@@ -95,6 +100,30 @@ func WithTxControl(ctx context.Context, txc *table.TransactionControl) context.C
 	return xsql.WithTxControl(ctx, txc)
 }
 
+// ScanComposite returns sql.Scanner for scanning values of List, Tuple, Struct and Dict columns
+// into slices, maps and structs (with fields tagged as in options.NewDescriptionFromStruct)
+//
+//	var ids []int
+//	err := db.QueryRowContext(ctx, "SELECT AsList(1, 2, 3)").Scan(ydb.ScanComposite(&ids))
+func ScanComposite(dst interface{}) sql.Scanner {
+	return xsql.ScanComposite(dst)
+}
+
+type BindMode = xsql.BindMode
+
+const (
+	// NamedBind passes query and named args as is
+	NamedBind = xsql.NamedBind
+
+	// PositionalBind rewrites `?` and `$1`, `$2`, ... placeholders into `$p0`, `$p1`, ... parameters
+	// and prepends query with DECLARE section generated from types of args
+	PositionalBind = xsql.PositionalBind
+)
+
+// LevelStaleReadOnly is an isolation level for read-only database/sql transactions which maps
+// to StaleReadOnly YDB transaction mode
+const LevelStaleReadOnly = isolation.LevelStaleReadOnly
+
 type ConnectorOption = xsql.ConnectorOption
 
 func WithDefaultQueryMode(mode QueryMode) ConnectorOption {
@@ -113,6 +142,18 @@ func WithDefaultScanQueryOptions(opts ...options.ExecuteScanQueryOption) Connect
 	return xsql.WithDefaultScanQueryOptions(opts...)
 }
 
+// WithRowsAffected enables RowsAffected of ExecContext results in data query mode.
+// Affected rows counts from basic query stats, so collecting of stats requests on each exec query
+func WithRowsAffected() ConnectorOption {
+	return xsql.WithRowsAffected()
+}
+
+// WithBindMode sets mode of binding database/sql args to YQL parameters.
+// Default is NamedBind
+func WithBindMode(mode BindMode) ConnectorOption {
+	return xsql.WithBindMode(mode)
+}
+
 func WithDatabaseSQLTrace(t trace.DatabaseSQL, opts ...trace.DatabaseSQLComposeOption) ConnectorOption {
 	return xsql.WithTrace(t, opts...)
 }
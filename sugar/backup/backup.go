@@ -0,0 +1,297 @@
+package backup
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
+	"github.com/ydb-platform/ydb-go-sdk/v3/scheme"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result/indexed"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+// Usage of this package
+//
+// err := backup.Backup(ctx, db, "path/to/dir", "/local/backup/dir")
+// err = backup.Restore(ctx, db, "/local/backup/dir", "path/to/restored/dir")
+
+const (
+	sysDirectory = ".sys"
+
+	defaultChunkSize   = 10000
+	defaultConcurrency = 4
+)
+
+// Progress describes current state of backup or restore of table
+type Progress struct {
+	// Table is a database root relative path of table
+	Table string
+
+	// Chunks is a count of written (backup) or loaded (restore) chunks of rows
+	Chunks int
+
+	// Rows is a count of written (backup) or loaded (restore) rows
+	Rows uint64
+
+	// Done reports that table processing is completed
+	Done bool
+}
+
+type backupOptions struct {
+	chunkSize   int
+	concurrency int
+	onProgress  func(Progress)
+	retryPolicy retry.Policy
+}
+
+// Option configures Backup and Restore
+type Option func(o *backupOptions)
+
+// WithChunkSize defines count of rows in single chunk file and single BulkUpsert call. Default is 10000
+func WithChunkSize(rows int) Option {
+	return func(o *backupOptions) {
+		if rows > 0 {
+			o.chunkSize = rows
+		}
+	}
+}
+
+// WithConcurrency defines count of parallel BulkUpsert calls while restore. Default is 4
+func WithConcurrency(n int) Option {
+	return func(o *backupOptions) {
+		if n > 0 {
+			o.concurrency = n
+		}
+	}
+}
+
+// WithProgress defines callback which calls after each written or loaded chunk of rows
+//
+// Callback may be called concurrently while restore
+func WithProgress(onProgress func(Progress)) Option {
+	return func(o *backupOptions) {
+		o.onProgress = onProgress
+	}
+}
+
+// WithRetryPolicy replaces default retry policy of scheme calls and
+// retry policy of table client for table calls
+func WithRetryPolicy(p retry.Policy) Option {
+	return func(o *backupOptions) {
+		o.retryPolicy = p
+	}
+}
+
+func newOptions(opts ...Option) *backupOptions {
+	o := &backupOptions{
+		chunkSize:   defaultChunkSize,
+		concurrency: defaultConcurrency,
+		onProgress:  func(Progress) {},
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(o)
+		}
+	}
+	return o
+}
+
+// Backup saves tables from database directory from (database root relative path) into local directory to
+//
+// Backup is resumable: repeated call with same arguments continues interrupted backup
+// from last written chunk of rows. Already completed tables are skipped
+func Backup(ctx context.Context, db ydb.Connection, from, to string, opts ...Option) error {
+	o := newOptions(opts...)
+	root := path.Join(db.Name(), from)
+	return walk(ctx, db, root, o, func(tablePath string) error {
+		relative := strings.TrimPrefix(strings.TrimPrefix(tablePath, root), "/")
+		return backupTable(ctx, db, tablePath, relative, filepath.Join(to, filepath.FromSlash(relative)), o)
+	})
+}
+
+// walk calls f for each table inside directory p recursively
+func walk(ctx context.Context, db ydb.Connection, p string, o *backupOptions, f func(tablePath string) error) error {
+	var (
+		entry scheme.Entry
+		dir   scheme.Directory
+	)
+	err := retry.Retry(ctx, func(ctx context.Context) (err error) {
+		entry, err = db.Scheme().DescribePath(ctx, p)
+		return err
+	}, retry.WithIdempotent(true), retry.WithPolicy(o.retryPolicy))
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	if entry.IsTable() {
+		return f(p)
+	}
+	if !entry.IsDirectory() && entry.Type != scheme.EntryDatabase {
+		return nil
+	}
+	err = retry.Retry(ctx, func(ctx context.Context) (err error) {
+		dir, err = db.Scheme().ListDirectory(ctx, p)
+		return err
+	}, retry.WithIdempotent(true), retry.WithPolicy(o.retryPolicy))
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	for _, child := range dir.Children {
+		childPath := path.Join(p, child.Name)
+		if childPath == path.Join(db.Name(), sysDirectory) {
+			continue
+		}
+		switch {
+		case child.IsTable():
+			err = f(childPath)
+		case child.IsDirectory():
+			err = walk(ctx, db, childPath, o, f)
+		default:
+			continue
+		}
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+	}
+	return nil
+}
+
+func backupTable(ctx context.Context, db ydb.Connection, tablePath, relative, dir string, o *backupOptions) error {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	var (
+		state     backupState
+		stateFile = filepath.Join(dir, backupFileName)
+	)
+	if _, err := readJSON(stateFile, &state); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	if state.Done {
+		o.onProgress(Progress{Table: relative, Chunks: state.Chunks, Rows: state.Rows, Done: true})
+		return nil
+	}
+	var desc options.Description
+	err := db.Table().Do(ctx, func(ctx context.Context, s table.Session) (err error) {
+		desc, err = s.DescribeTable(ctx, tablePath)
+		return err
+	}, table.WithIdempotent(), table.WithRetryPolicy(o.retryPolicy))
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	schema, err := marshalSchema(desc)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	if err = writeFile(filepath.Join(dir, schemaFileName), schema); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	keyIndexes := make([]int, len(desc.PrimaryKey))
+	for i, key := range desc.PrimaryKey {
+		keyIndexes[i] = -1
+		for j, c := range desc.Columns {
+			if c.Name == key {
+				keyIndexes[i] = j
+			}
+		}
+		if keyIndexes[i] < 0 {
+			return xerrors.WithStackTrace(fmt.Errorf("primary key column %q not found in table %q", key, tablePath))
+		}
+	}
+	flush := func(rows []types.Value, lastKey types.Value) error {
+		if len(rows) == 0 {
+			return nil
+		}
+		if err := writeChunk(dir, state.Chunks, rows); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		key, err := marshalValue(lastKey)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		next := state
+		next.Chunks++
+		next.Rows += uint64(len(rows))
+		next.LastKey = key
+		if err = writeJSON(stateFile, next); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		state = next
+		o.onProgress(Progress{Table: relative, Chunks: state.Chunks, Rows: state.Rows})
+		return nil
+	}
+	err = db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
+		readOpts := []options.ReadTableOption{
+			options.ReadOrdered(),
+		}
+		for _, c := range desc.Columns {
+			readOpts = append(readOpts, options.ReadColumn(c.Name))
+		}
+		if state.LastKey != nil {
+			lastKey, err := unmarshalValue(state.LastKey)
+			if err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+			readOpts = append(readOpts, options.ReadGreater(lastKey))
+		}
+		res, err := s.StreamReadTable(ctx, tablePath, readOpts...)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		defer func() {
+			_ = res.Close()
+		}()
+		var (
+			rows    = make([]types.Value, 0, o.chunkSize)
+			lastKey types.Value
+			values  = make([]types.Value, len(desc.Columns))
+			dst     = make([]indexed.RequiredOrOptional, len(desc.Columns))
+		)
+		for i := range values {
+			dst[i] = &values[i]
+		}
+		for res.NextResultSet(ctx) {
+			for res.NextRow() {
+				if err = res.Scan(dst...); err != nil {
+					return xerrors.WithStackTrace(err)
+				}
+				fields := make([]types.StructValueOption, len(desc.Columns))
+				for i, c := range desc.Columns {
+					fields[i] = types.StructFieldValue(c.Name, values[i])
+				}
+				rows = append(rows, types.StructValue(fields...))
+				key := make([]types.Value, len(keyIndexes))
+				for i, j := range keyIndexes {
+					key[i] = values[j]
+				}
+				lastKey = types.TupleValue(key...)
+				if len(rows) >= o.chunkSize {
+					if err = flush(rows, lastKey); err != nil {
+						return xerrors.WithStackTrace(err)
+					}
+					rows = rows[:0]
+				}
+			}
+		}
+		if err = res.Err(); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		return flush(rows, lastKey)
+	}, table.WithIdempotent(), table.WithRetryPolicy(o.retryPolicy))
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	state.Done = true
+	if err = writeJSON(stateFile, state); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	o.onProgress(Progress{Table: relative, Chunks: state.Chunks, Rows: state.Rows, Done: true})
+	return nil
+}
@@ -0,0 +1,213 @@
+package backup
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+// Local backup format
+//
+// Each table stores in own directory (relative path of directory equals to relative path of table)
+// and consists of files:
+//   - schema.json - table schema as JSON form of Ydb.Table.CreateTableRequest message
+//   - data_<NNNNNNNN>.pb - rows chunk as binary form of Ydb.TypedValue message with List<Struct<...>> value
+//   - backup.json - progress of backup (count of written chunks, last written primary key)
+//   - restore.json - progress of restore (target table and loaded chunks)
+
+const (
+	schemaFileName  = "schema.json"
+	backupFileName  = "backup.json"
+	restoreFileName = "restore.json"
+	chunkFilePrefix = "data_"
+	chunkFileSuffix = ".pb"
+)
+
+// backupState is a progress of table backup
+type backupState struct {
+	Chunks  int    `json:"chunks"`
+	Rows    uint64 `json:"rows"`
+	LastKey []byte `json:"last_key,omitempty"`
+	Done    bool   `json:"done"`
+}
+
+// restoreState is a progress of table restore
+type restoreState struct {
+	Target string `json:"target"`
+	Loaded []bool `json:"loaded"`
+	Rows   uint64 `json:"rows"`
+	Done   bool   `json:"done"`
+}
+
+// markLoaded marks chunk i with n rows as loaded and returns count of loaded chunks and rows
+// (including chunks loaded by interrupted restore)
+func (s *restoreState) markLoaded(i, n int) (chunks int, rows uint64) {
+	s.Loaded[i] = true
+	s.Rows += uint64(n)
+	for _, loaded := range s.Loaded {
+		if loaded {
+			chunks++
+		}
+	}
+	return chunks, s.Rows
+}
+
+func chunkFileName(i int) string {
+	return fmt.Sprintf("%s%08d%s", chunkFilePrefix, i, chunkFileSuffix)
+}
+
+// readJSON reads file into dst. Missing file is not an error and reports with false
+func readJSON(fileName string, dst interface{}) (bool, error) {
+	content, err := os.ReadFile(fileName)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, xerrors.WithStackTrace(err)
+	}
+	if err = json.Unmarshal(content, dst); err != nil {
+		return false, xerrors.WithStackTrace(fmt.Errorf("%s: %w", fileName, err))
+	}
+	return true, nil
+}
+
+// writeFile writes file atomically with temporary file and rename
+func writeFile(fileName string, content []byte) error {
+	tmp := fileName + ".tmp"
+	if err := os.WriteFile(tmp, content, 0o600); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	if err := os.Rename(tmp, fileName); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	return nil
+}
+
+func writeJSON(fileName string, src interface{}) error {
+	content, err := json.MarshalIndent(src, "", "  ")
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	return writeFile(fileName, content)
+}
+
+// createTableOptions makes options for creating table same as described one
+func createTableOptions(d options.Description) []options.CreateTableOption {
+	opts := make([]options.CreateTableOption, 0, len(d.Columns)+len(d.Indexes)+len(d.Attributes)+8)
+	for _, c := range d.Columns {
+		opts = append(opts, options.WithColumnMeta(c))
+	}
+	opts = append(opts, options.WithPrimaryKeyColumn(d.PrimaryKey...))
+	for _, idx := range d.Indexes {
+		indexOpts := []options.IndexOption{
+			options.WithIndexColumns(idx.IndexColumns...),
+		}
+		if len(idx.DataColumns) > 0 {
+			indexOpts = append(indexOpts, options.WithDataColumns(idx.DataColumns...))
+		}
+		if idx.Type != nil {
+			indexOpts = append(indexOpts, options.WithIndexType(idx.Type))
+		}
+		opts = append(opts, options.WithIndex(idx.Name, indexOpts...))
+	}
+	if len(d.ColumnFamilies) > 0 {
+		opts = append(opts, options.WithColumnFamilies(d.ColumnFamilies...))
+	}
+	for k, v := range d.Attributes {
+		opts = append(opts, options.WithAttribute(k, v))
+	}
+	if d.TimeToLiveSettings != nil {
+		opts = append(opts, options.WithTimeToLiveSettings(*d.TimeToLiveSettings))
+	}
+	opts = append(opts,
+		options.WithPartitioningSettingsObject(d.PartitioningSettings),
+		options.WithStorageSettings(d.StorageSettings),
+		options.WithKeyBloomFilter(d.KeyBloomFilter),
+	)
+	if d.ReadReplicaSettings.Count != 0 {
+		opts = append(opts, options.WithReadReplicasSettings(d.ReadReplicaSettings))
+	}
+	return opts
+}
+
+func marshalSchema(d options.Description) ([]byte, error) {
+	var (
+		request Ydb_Table.CreateTableRequest
+		a       = allocator.New()
+	)
+	defer a.Free()
+	for _, opt := range createTableOptions(d) {
+		opt((*options.CreateTableDesc)(&request), a)
+	}
+	content, err := protojson.MarshalOptions{Multiline: true}.Marshal(&request)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	return content, nil
+}
+
+func unmarshalSchema(content []byte) (*Ydb_Table.CreateTableRequest, error) {
+	var request Ydb_Table.CreateTableRequest
+	if err := protojson.Unmarshal(content, &request); err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	return &request, nil
+}
+
+// withSchema makes create table option which applies saved schema
+func withSchema(schema *Ydb_Table.CreateTableRequest) options.CreateTableOption {
+	return func(d *options.CreateTableDesc, a *allocator.Allocator) {
+		proto.Merge((*Ydb_Table.CreateTableRequest)(d), schema)
+	}
+}
+
+func marshalValue(v types.Value) ([]byte, error) {
+	a := allocator.New()
+	defer a.Free()
+	content, err := proto.Marshal(value.ToYDB(v, a))
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	return content, nil
+}
+
+func unmarshalValue(content []byte) (types.Value, error) {
+	var v Ydb.TypedValue
+	if err := proto.Unmarshal(content, &v); err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	return value.FromYDB(v.GetType(), v.GetValue()), nil
+}
+
+func writeChunk(dir string, i int, rows []types.Value) error {
+	content, err := marshalValue(types.ListValue(rows...))
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	return writeFile(filepath.Join(dir, chunkFileName(i)), content)
+}
+
+// readChunk reads chunk of rows and returns it with count of rows
+func readChunk(dir string, i int) (types.Value, int, error) {
+	content, err := os.ReadFile(filepath.Join(dir, chunkFileName(i)))
+	if err != nil {
+		return nil, 0, xerrors.WithStackTrace(err)
+	}
+	var v Ydb.TypedValue
+	if err = proto.Unmarshal(content, &v); err != nil {
+		return nil, 0, xerrors.WithStackTrace(err)
+	}
+	return value.FromYDB(v.GetType(), v.GetValue()), len(v.GetValue().GetItems()), nil
+}
@@ -0,0 +1,90 @@
+package backup
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+func TestChunk(t *testing.T) {
+	dir := t.TempDir()
+	rows := []types.Value{
+		types.StructValue(
+			types.StructFieldValue("id", types.OptionalValue(types.Uint64Value(1))),
+			types.StructFieldValue("title", types.OptionalValue(types.UTF8Value("a"))),
+		),
+		types.StructValue(
+			types.StructFieldValue("id", types.OptionalValue(types.Uint64Value(2))),
+			types.StructFieldValue("title", types.NullValue(types.TypeUTF8)),
+		),
+	}
+	require.NoError(t, writeChunk(dir, 3, rows))
+	require.FileExists(t, filepath.Join(dir, "data_00000003.pb"))
+	v, n, err := readChunk(dir, 3)
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+	require.Equal(t, types.ListValue(rows...).String(), v.String())
+}
+
+func TestSchema(t *testing.T) {
+	desc := options.Description{
+		Columns: []options.Column{
+			{Name: "id", Type: types.Optional(types.TypeUint64)},
+			{Name: "title", Type: types.Optional(types.TypeUTF8)},
+		},
+		PrimaryKey: []string{"id"},
+		Indexes: []options.IndexDescription{
+			{
+				Name:         "title_index",
+				IndexColumns: []string{"title"},
+				Type:         options.GlobalAsyncIndex(),
+			},
+		},
+		Attributes: map[string]string{"k": "v"},
+	}
+	content, err := marshalSchema(desc)
+	require.NoError(t, err)
+	schema, err := unmarshalSchema(content)
+	require.NoError(t, err)
+	restored := options.NewDescription(withSchema(schema))
+	require.Equal(t, desc.Columns, restored.Columns)
+	require.Equal(t, desc.PrimaryKey, restored.PrimaryKey)
+	require.Equal(t, desc.Indexes, restored.Indexes)
+	require.Equal(t, desc.Attributes, restored.Attributes)
+}
+
+func TestState(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), backupFileName)
+	var state backupState
+	ok, err := readJSON(fileName, &state)
+	require.NoError(t, err)
+	require.False(t, ok)
+	key, err := marshalValue(types.TupleValue(types.OptionalValue(types.Uint64Value(42))))
+	require.NoError(t, err)
+	require.NoError(t, writeJSON(fileName, backupState{Chunks: 2, Rows: 20, LastKey: key}))
+	ok, err = readJSON(fileName, &state)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, 2, state.Chunks)
+	require.Equal(t, uint64(20), state.Rows)
+	lastKey, err := unmarshalValue(state.LastKey)
+	require.NoError(t, err)
+	require.Equal(t, "Tuple<Optional<Uint64>>((42))", lastKey.String())
+}
+
+func TestRestoreState(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), restoreFileName)
+	require.NoError(t, writeJSON(fileName, restoreState{Target: "a", Loaded: []bool{true, false, false}, Rows: 10}))
+	var state restoreState
+	ok, err := readJSON(fileName, &state)
+	require.NoError(t, err)
+	require.True(t, ok)
+	chunks, rows := state.markLoaded(2, 5)
+	require.Equal(t, 2, chunks)
+	require.Equal(t, uint64(15), rows)
+	require.Equal(t, []bool{true, false, true}, state.Loaded)
+}
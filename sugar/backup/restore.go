@@ -0,0 +1,191 @@
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sync"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/sugar"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+)
+
+// ErrIncompleteBackup returns from Restore if local backup of table is not completed
+var ErrIncompleteBackup = errors.New("incomplete backup")
+
+// Restore loads tables from local directory from into database directory to (database root relative path)
+//
+// Missing tables are created with saved schema, existing tables are upserted with saved rows.
+// Restore is resumable: repeated call with same arguments loads only not loaded chunks of rows.
+// Already restored tables are skipped
+func Restore(ctx context.Context, db ydb.Connection, from, to string, opts ...Option) error {
+	o := newOptions(opts...)
+	var dirs []string
+	err := filepath.WalkDir(from, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() && d.Name() == schemaFileName {
+			dirs = append(dirs, filepath.Dir(p))
+		}
+		return nil
+	})
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	for _, dir := range dirs {
+		relative, err := filepath.Rel(from, dir)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		relative = filepath.ToSlash(relative)
+		if err = restoreTable(ctx, db, dir, relative, path.Join(to, relative), o); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+	}
+	return nil
+}
+
+func restoreTable(ctx context.Context, db ydb.Connection, dir, relative, target string, o *backupOptions) error {
+	var (
+		backup    backupState
+		state     restoreState
+		stateFile = filepath.Join(dir, restoreFileName)
+	)
+	if _, err := readJSON(filepath.Join(dir, backupFileName), &backup); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	if !backup.Done {
+		return xerrors.WithStackTrace(fmt.Errorf("%w: %s", ErrIncompleteBackup, dir))
+	}
+	if _, err := readJSON(stateFile, &state); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	if state.Target != target || len(state.Loaded) != backup.Chunks {
+		state = restoreState{
+			Target: target,
+			Loaded: make([]bool, backup.Chunks),
+		}
+	}
+	if state.Done {
+		o.onProgress(Progress{Table: relative, Chunks: backup.Chunks, Rows: backup.Rows, Done: true})
+		return nil
+	}
+	content, err := os.ReadFile(filepath.Join(dir, schemaFileName))
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	schema, err := unmarshalSchema(content)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	if parent := path.Dir(target); parent != "." && parent != "/" {
+		if err = sugar.MakeRecursive(ctx, db, parent, sugar.WithRetryPolicy(o.retryPolicy)); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+	}
+	tablePath := path.Join(db.Name(), target)
+	err = db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
+		_, err := s.DescribeTable(ctx, tablePath)
+		if err == nil {
+			return nil
+		}
+		if !ydb.IsOperationErrorSchemeError(err) {
+			return xerrors.WithStackTrace(err)
+		}
+		return s.CreateTable(ctx, tablePath, withSchema(schema))
+	}, table.WithIdempotent(), table.WithRetryPolicy(o.retryPolicy))
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	if err = loadChunks(ctx, db, dir, relative, tablePath, &state, o); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	state.Done = true
+	if err = writeJSON(stateFile, state); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	o.onProgress(Progress{Table: relative, Chunks: backup.Chunks, Rows: backup.Rows, Done: true})
+	return nil
+}
+
+// loadChunks upserts not loaded chunks of rows with o.concurrency parallel workers
+func loadChunks(
+	ctx context.Context,
+	db ydb.Connection,
+	dir, relative, tablePath string,
+	state *restoreState,
+	o *backupOptions,
+) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		firstErr error
+		jobs     = make(chan int)
+		fail     = func(err error) {
+			mu.Lock()
+			defer mu.Unlock()
+			if firstErr == nil {
+				firstErr = err
+			}
+			cancel()
+		}
+	)
+	for w := 0; w < o.concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				chunk, n, err := readChunk(dir, i)
+				if err != nil {
+					fail(err)
+					return
+				}
+				err = db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
+					return s.BulkUpsert(ctx, tablePath, chunk)
+				}, table.WithIdempotent(), table.WithRetryPolicy(o.retryPolicy))
+				if err != nil {
+					fail(err)
+					return
+				}
+				mu.Lock()
+				chunks, rows := state.markLoaded(i, n)
+				err = writeJSON(filepath.Join(dir, restoreFileName), state)
+				progress := Progress{Table: relative, Chunks: chunks, Rows: rows}
+				mu.Unlock()
+				if err != nil {
+					fail(err)
+					return
+				}
+				o.onProgress(progress)
+			}
+		}()
+	}
+	for i, loaded := range state.Loaded {
+		if loaded {
+			continue
+		}
+		select {
+		case jobs <- i:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	close(jobs)
+	wg.Wait()
+	if firstErr != nil {
+		return xerrors.WithStackTrace(firstErr)
+	}
+	return xerrors.WithStackTrace(ctx.Err())
+}
@@ -0,0 +1,182 @@
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xrand"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result/named"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+var (
+	// ErrLocked returns when lock is held by another migrator
+	ErrLocked = errors.New("migrations locked by another owner")
+
+	// ErrLockLost returns when lease of acquired lock cannot be extended while migrations run
+	ErrLockLost = errors.New("migrations lock lost")
+)
+
+// Locker guards migrations from concurrent applying by several instances
+//
+// Default Locker is an optimistic-transaction row in lock table (see WithLockTable).
+// Any other distributed lock (for example, based on a coordination node semaphore)
+// may be plugged with WithLocker option
+type Locker interface {
+	// Lock blocks until lock acquired or context done
+	Lock(ctx context.Context) error
+
+	// Unlock releases lock acquired by Lock
+	Unlock(ctx context.Context) error
+}
+
+// Renewer is an optional interface of Locker with lease which expires if not extended
+//
+// Migrator renews lock every third of lock time-to-live while migrations run
+// and cancels migrations with ErrLockLost if Renew fails
+type Renewer interface {
+	// Renew extends lease of lock acquired by Lock. Renew fails if lock is held by another owner
+	Renew(ctx context.Context) error
+}
+
+type nopLocker struct{}
+
+func (nopLocker) Lock(context.Context) error { return nil }
+
+func (nopLocker) Unlock(context.Context) error { return nil }
+
+// tableLocker is a Locker over single row in YDB table
+//
+// Lock row upserts inside serializable read-write transaction, so concurrent
+// acquiring breaks on commit with optimistic locks invalidation and retries by table.Client.DoTx
+type tableLocker struct {
+	client    table.Client
+	tablePath string
+	owner     string
+	ttl       time.Duration
+	interval  time.Duration
+}
+
+func newTableLocker(client table.Client, tablePath string, ttl time.Duration) *tableLocker {
+	hostname, _ := os.Hostname()
+	return &tableLocker{
+		client:    client,
+		tablePath: tablePath,
+		owner:     fmt.Sprintf("%s-%d-%d", hostname, os.Getpid(), xrand.New(xrand.WithLock()).Int64(1<<62)),
+		ttl:       ttl,
+		interval:  time.Second,
+	}
+}
+
+func (l *tableLocker) createTable(ctx context.Context) error {
+	return createTableIfNotExists(ctx, l.client, l.tablePath,
+		options.WithColumn("id", types.Optional(types.TypeUTF8)),
+		options.WithColumn("owner", types.Optional(types.TypeUTF8)),
+		options.WithColumn("expires_at", types.Optional(types.TypeTimestamp)),
+		options.WithPrimaryKeyColumn("id"),
+	)
+}
+
+// tryLock acquires free or expired lock row (or lock row of same owner) and extends its lease.
+// Lock row of same owner only is extended if renew flag is set
+func (l *tableLocker) tryLock(ctx context.Context, renew bool) error {
+	var (
+		selectQuery = fmt.Sprintf(`
+			DECLARE $id AS Utf8;
+			SELECT owner, expires_at FROM `+"`%s`"+` WHERE id = $id;
+		`, l.tablePath)
+		upsertQuery = fmt.Sprintf(`
+			DECLARE $id AS Utf8;
+			DECLARE $owner AS Utf8;
+			DECLARE $expiresAt AS Timestamp;
+			UPSERT INTO `+"`%s`"+` (id, owner, expires_at) VALUES ($id, $owner, $expiresAt);
+		`, l.tablePath)
+	)
+	return l.client.DoTx(ctx, func(ctx context.Context, tx table.TransactionActor) error {
+		res, err := tx.Execute(ctx, selectQuery, table.NewQueryParameters(
+			table.ValueParam("$id", types.TextValue(lockID)),
+		))
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		defer func() {
+			_ = res.Close()
+		}()
+		var (
+			owner     string
+			expiresAt time.Time
+		)
+		if err = res.NextResultSetErr(ctx); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		if res.NextRow() {
+			if err = res.ScanNamed(
+				named.OptionalWithDefault("owner", &owner),
+				named.OptionalWithDefault("expires_at", &expiresAt),
+			); err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+		}
+		if err = res.Err(); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		now := time.Now()
+		if renew && owner != l.owner {
+			return xerrors.WithStackTrace(fmt.Errorf("%w: lock owner is %q", ErrLockLost, owner))
+		}
+		if owner != "" && owner != l.owner && expiresAt.After(now) {
+			return xerrors.WithStackTrace(fmt.Errorf("%w: %q till %v", ErrLocked, owner, expiresAt))
+		}
+		_, err = tx.Execute(ctx, upsertQuery, table.NewQueryParameters(
+			table.ValueParam("$id", types.TextValue(lockID)),
+			table.ValueParam("$owner", types.TextValue(l.owner)),
+			table.ValueParam("$expiresAt", types.TimestampValueFromTime(now.Add(l.ttl))),
+		))
+		return err
+	}, table.WithIdempotent())
+}
+
+func (l *tableLocker) Lock(ctx context.Context) error {
+	if err := l.createTable(ctx); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	for {
+		err := l.tryLock(ctx, false)
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, ErrLocked) {
+			return xerrors.WithStackTrace(err)
+		}
+		select {
+		case <-ctx.Done():
+			return xerrors.WithStackTrace(ctx.Err())
+		case <-time.After(l.interval):
+		}
+	}
+}
+
+func (l *tableLocker) Renew(ctx context.Context) error {
+	return l.tryLock(ctx, true)
+}
+
+func (l *tableLocker) Unlock(ctx context.Context) error {
+	query := fmt.Sprintf(`
+		DECLARE $id AS Utf8;
+		DECLARE $owner AS Utf8;
+		DELETE FROM `+"`%s`"+` WHERE id = $id AND owner = $owner;
+	`, l.tablePath)
+	return l.client.Do(ctx, func(ctx context.Context, s table.Session) error {
+		_, _, err := s.Execute(ctx, table.DefaultTxControl(), query, table.NewQueryParameters(
+			table.ValueParam("$id", types.TextValue(lockID)),
+			table.ValueParam("$owner", types.TextValue(l.owner)),
+		))
+		return err
+	}, table.WithIdempotent())
+}
@@ -0,0 +1,349 @@
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"path"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result/named"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+// Usage of this package
+//
+// migrations, err := migrate.FromFS(embeddedFS, "migrations")
+// m, err := migrate.New(db, migrations)
+// err = m.Up(ctx)
+
+const (
+	defaultTable   = "schema_migrations"
+	defaultLockTTL = time.Hour
+
+	lockID = "migrations"
+)
+
+// ErrIrreversible returns when down migration requested for migration without Down step
+var ErrIrreversible = errors.New("irreversible migration")
+
+// Applied describes migration which applied to database
+type Applied struct {
+	Version   uint64
+	Name      string
+	AppliedAt time.Time
+}
+
+type migrateOptions struct {
+	table     string
+	lockTable string
+	lockTTL   time.Duration
+	locker    Locker
+}
+
+// Option configures Migrator
+type Option func(o *migrateOptions)
+
+// WithTable defines name of applied versions table (relative to database root). Default is `schema_migrations`
+func WithTable(name string) Option {
+	return func(o *migrateOptions) {
+		o.table = name
+	}
+}
+
+// WithLockTable defines name of lock table (relative to database root) and lock time-to-live
+//
+// By default lock table is `<versions table>_lock` and time-to-live is one hour.
+// Lock is renewed every third of ttl while migrations run.
+// Lock which not released during ttl (for example, after crash of migrator) may be acquired by another migrator
+func WithLockTable(name string, ttl time.Duration) Option {
+	return func(o *migrateOptions) {
+		o.lockTable = name
+		o.lockTTL = ttl
+	}
+}
+
+// WithLocker replaces default table-based locker.
+// Locker which implements Renewer is renewed every third of lock time-to-live (see WithLockTable)
+func WithLocker(locker Locker) Option {
+	return func(o *migrateOptions) {
+		o.locker = locker
+	}
+}
+
+// WithoutLock disables locking of migrations
+func WithoutLock() Option {
+	return WithLocker(nopLocker{})
+}
+
+// Migrator applies and reverts migrations
+type Migrator struct {
+	client     table.Client
+	tablePath  string
+	locker     Locker
+	renewEvery time.Duration
+	migrations []Migration
+}
+
+// New makes Migrator for database db with given migrations
+func New(db ydb.Connection, migrations []Migration, opts ...Option) (*Migrator, error) {
+	o := &migrateOptions{
+		table:   defaultTable,
+		lockTTL: defaultLockTTL,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if o.lockTable == "" {
+		o.lockTable = o.table + "_lock"
+	}
+	sorted, err := validate(migrations)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	m := &Migrator{
+		client:     db.Table(),
+		tablePath:  path.Join(db.Name(), o.table),
+		locker:     o.locker,
+		renewEvery: o.lockTTL / 3,
+		migrations: sorted,
+	}
+	if m.locker == nil {
+		m.locker = newTableLocker(m.client, path.Join(db.Name(), o.lockTable), o.lockTTL)
+	}
+	return m, nil
+}
+
+// Up applies all not applied migrations
+func (m *Migrator) Up(ctx context.Context) error {
+	return m.UpTo(ctx, math.MaxUint64)
+}
+
+// UpTo applies not applied migrations with versions less or equal than version
+func (m *Migrator) UpTo(ctx context.Context, version uint64) error {
+	return m.locked(ctx, func(ctx context.Context) error {
+		applied, err := m.appliedVersions(ctx)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		for _, migration := range planUp(m.migrations, applied, version) {
+			if err = m.apply(ctx, migration, migration.Up, true); err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+		}
+		return nil
+	})
+}
+
+// Down reverts last applied migration
+func (m *Migrator) Down(ctx context.Context) error {
+	return m.locked(ctx, func(ctx context.Context) error {
+		applied, err := m.appliedVersions(ctx)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		plan, err := planDown(m.migrations, applied, 0)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		if len(plan) == 0 {
+			return nil
+		}
+		return m.apply(ctx, plan[0], plan[0].Down, false)
+	})
+}
+
+// DownTo reverts applied migrations with versions greater than version
+//
+// DownTo(ctx, 0) reverts all applied migrations
+func (m *Migrator) DownTo(ctx context.Context, version uint64) error {
+	return m.locked(ctx, func(ctx context.Context) error {
+		applied, err := m.appliedVersions(ctx)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		plan, err := planDown(m.migrations, applied, version)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		for _, migration := range plan {
+			if err = m.apply(ctx, migration, migration.Down, false); err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+		}
+		return nil
+	})
+}
+
+// Applied returns applied migrations in ascending order of versions
+func (m *Migrator) Applied(ctx context.Context) (applied []Applied, _ error) {
+	if err := m.createTable(ctx); err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	query := fmt.Sprintf("SELECT version, name, applied_at FROM `%s` ORDER BY version;", m.tablePath)
+	err := m.client.Do(ctx, func(ctx context.Context, s table.Session) error {
+		applied = applied[:0]
+		_, res, err := s.Execute(ctx, table.OnlineReadOnlyTxControl(), query, nil)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		defer func() {
+			_ = res.Close()
+		}()
+		if err = res.NextResultSetErr(ctx); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		for res.NextRow() {
+			var a Applied
+			if err = res.ScanNamed(
+				named.OptionalWithDefault("version", &a.Version),
+				named.OptionalWithDefault("name", &a.Name),
+				named.OptionalWithDefault("applied_at", &a.AppliedAt),
+			); err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+			applied = append(applied, a)
+		}
+		return res.Err()
+	}, table.WithIdempotent())
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	return applied, nil
+}
+
+func (m *Migrator) locked(ctx context.Context, f func(ctx context.Context) error) (err error) {
+	if err = m.locker.Lock(ctx); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	defer func() {
+		if unlockErr := m.locker.Unlock(ctx); unlockErr != nil && err == nil {
+			err = xerrors.WithStackTrace(unlockErr)
+		}
+	}()
+	renewer, ok := m.locker.(Renewer)
+	if !ok || m.renewEvery <= 0 {
+		return f(ctx)
+	}
+	renewCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	renewErr := make(chan error, 1)
+	go func() {
+		defer cancel()
+		renewErr <- renew(renewCtx, renewer, m.renewEvery)
+	}()
+	err = f(renewCtx)
+	cancel()
+	if e := <-renewErr; e != nil {
+		return xerrors.WithStackTrace(e)
+	}
+	return err
+}
+
+// renew extends lease of lock periodically until ctx done.
+// Returns error of failed renewal (renewals are not retried after failure)
+func renew(ctx context.Context, r Renewer, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := r.Renew(ctx); err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				if !errors.Is(err, ErrLockLost) {
+					err = fmt.Errorf("%w: %v", ErrLockLost, err)
+				}
+				return err
+			}
+		}
+	}
+}
+
+func (m *Migrator) appliedVersions(ctx context.Context) (map[uint64]bool, error) {
+	applied, err := m.Applied(ctx)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	versions := make(map[uint64]bool, len(applied))
+	for _, a := range applied {
+		versions[a.Version] = true
+	}
+	return versions, nil
+}
+
+// apply runs migration step and marks migration as applied (up) or not applied (down)
+//
+// Schema queries cannot be executed inside transaction, so step and bookkeeping are not atomic.
+// Failed bookkeeping after successful step requires manual fix of versions table
+func (m *Migrator) apply(ctx context.Context, migration Migration, step Step, up bool) error {
+	if err := m.client.Do(ctx, func(ctx context.Context, s table.Session) error {
+		return step(ctx, s)
+	}); err != nil {
+		return xerrors.WithStackTrace(fmt.Errorf("migration %d (%s) failed: %w", migration.Version, migration.Name, err))
+	}
+	var (
+		query  string
+		params *table.QueryParameters
+	)
+	if up {
+		query = fmt.Sprintf(`
+			DECLARE $version AS Uint64;
+			DECLARE $name AS Utf8;
+			DECLARE $appliedAt AS Timestamp;
+			UPSERT INTO `+"`%s`"+` (version, name, applied_at) VALUES ($version, $name, $appliedAt);
+		`, m.tablePath)
+		params = table.NewQueryParameters(
+			table.ValueParam("$version", types.Uint64Value(migration.Version)),
+			table.ValueParam("$name", types.TextValue(migration.Name)),
+			table.ValueParam("$appliedAt", types.TimestampValueFromTime(time.Now())),
+		)
+	} else {
+		query = fmt.Sprintf(`
+			DECLARE $version AS Uint64;
+			DELETE FROM `+"`%s`"+` WHERE version = $version;
+		`, m.tablePath)
+		params = table.NewQueryParameters(
+			table.ValueParam("$version", types.Uint64Value(migration.Version)),
+		)
+	}
+	return m.client.Do(ctx, func(ctx context.Context, s table.Session) error {
+		_, _, err := s.Execute(ctx, table.DefaultTxControl(), query, params)
+		return err
+	}, table.WithIdempotent())
+}
+
+func (m *Migrator) createTable(ctx context.Context) error {
+	return createTableIfNotExists(ctx, m.client, m.tablePath,
+		options.WithColumn("version", types.Optional(types.TypeUint64)),
+		options.WithColumn("name", types.Optional(types.TypeUTF8)),
+		options.WithColumn("applied_at", types.Optional(types.TypeTimestamp)),
+		options.WithPrimaryKeyColumn("version"),
+	)
+}
+
+func createTableIfNotExists(
+	ctx context.Context,
+	client table.Client,
+	tablePath string,
+	opts ...options.CreateTableOption,
+) error {
+	return client.Do(ctx, func(ctx context.Context, s table.Session) error {
+		_, err := s.DescribeTable(ctx, tablePath)
+		if err == nil {
+			return nil
+		}
+		if !ydb.IsOperationErrorSchemeError(err) {
+			return xerrors.WithStackTrace(err)
+		}
+		return s.CreateTable(ctx, tablePath, opts...)
+	}, table.WithIdempotent())
+}
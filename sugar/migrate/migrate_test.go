@@ -0,0 +1,175 @@
+package migrate
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+)
+
+func nopStep(context.Context, table.Session) error { return nil }
+
+func versions(migrations []Migration) (v []uint64) {
+	for _, m := range migrations {
+		v = append(v, m.Version)
+	}
+	return v
+}
+
+func TestParseFileName(t *testing.T) {
+	for _, tt := range []struct {
+		fileName string
+		version  uint64
+		name     string
+		up       bool
+		ok       bool
+	}{
+		{fileName: "0001_create_users.up.sql", version: 1, name: "create_users", up: true, ok: true},
+		{fileName: "0001_create_users.down.sql", version: 1, name: "create_users", up: false, ok: true},
+		{fileName: "42.up.yql", version: 42, name: "", up: true, ok: true},
+		{fileName: "0000_zero.up.sql", ok: false},
+		{fileName: "abc_create.up.sql", ok: false},
+		{fileName: "0001_create_users.sql", ok: false},
+		{fileName: "README.md", ok: false},
+	} {
+		t.Run(tt.fileName, func(t *testing.T) {
+			version, name, up, ok := parseFileName(tt.fileName)
+			require.Equal(t, tt.ok, ok)
+			if !tt.ok {
+				return
+			}
+			require.Equal(t, tt.version, version)
+			require.Equal(t, tt.name, name)
+			require.Equal(t, tt.up, up)
+		})
+	}
+}
+
+func TestFromFS(t *testing.T) {
+	t.Run("OK", func(t *testing.T) {
+		migrations, err := FromFS(fstest.MapFS{
+			"migrations/0002_add_index.up.sql":      {Data: []byte("ALTER TABLE users ADD INDEX ...;")},
+			"migrations/0001_create_users.up.sql":   {Data: []byte("CREATE TABLE users (...);")},
+			"migrations/0001_create_users.down.sql": {Data: []byte("DROP TABLE users;")},
+			"migrations/README.md":                  {Data: []byte("docs")},
+		}, "migrations")
+		require.NoError(t, err)
+		require.Equal(t, []uint64{1, 2}, versions(migrations))
+		require.Equal(t, "create_users", migrations[0].Name)
+		require.NotNil(t, migrations[0].Up)
+		require.NotNil(t, migrations[0].Down)
+		require.NotNil(t, migrations[1].Up)
+		require.Nil(t, migrations[1].Down)
+	})
+	t.Run("WithoutUp", func(t *testing.T) {
+		_, err := FromFS(fstest.MapFS{
+			"migrations/0001_create_users.down.sql": {Data: []byte("DROP TABLE users;")},
+		}, "migrations")
+		require.Error(t, err)
+	})
+	t.Run("DifferentNames", func(t *testing.T) {
+		_, err := FromFS(fstest.MapFS{
+			"migrations/0001_create_users.up.sql": {Data: []byte("CREATE TABLE users (...);")},
+			"migrations/0001_users.down.sql":      {Data: []byte("DROP TABLE users;")},
+		}, "migrations")
+		require.Error(t, err)
+	})
+}
+
+func TestValidate(t *testing.T) {
+	sorted, err := validate([]Migration{
+		Func(3, "c", nopStep, nil),
+		Func(1, "a", nopStep, nil),
+		Func(2, "b", nopStep, nil),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2, 3}, versions(sorted))
+
+	_, err = validate([]Migration{
+		Func(1, "a", nopStep, nil),
+		Func(1, "b", nopStep, nil),
+	})
+	require.Error(t, err)
+
+	_, err = validate([]Migration{
+		Func(1, "a", nil, nil),
+	})
+	require.Error(t, err)
+}
+
+func TestPlan(t *testing.T) {
+	migrations := []Migration{
+		Func(1, "a", nopStep, nopStep),
+		Func(2, "b", nopStep, nopStep),
+		Func(3, "c", nopStep, nil),
+		Func(4, "d", nopStep, nopStep),
+	}
+	t.Run("Up", func(t *testing.T) {
+		require.Equal(t, []uint64{2, 3, 4}, versions(planUp(migrations, map[uint64]bool{1: true}, 10)))
+		require.Equal(t, []uint64{2}, versions(planUp(migrations, map[uint64]bool{1: true}, 2)))
+		require.Empty(t, planUp(migrations, map[uint64]bool{1: true, 2: true, 3: true, 4: true}, 10))
+	})
+	t.Run("Down", func(t *testing.T) {
+		plan, err := planDown(migrations, map[uint64]bool{1: true, 2: true, 4: true}, 0)
+		require.NoError(t, err)
+		require.Equal(t, []uint64{4, 2, 1}, versions(plan))
+		plan, err = planDown(migrations, map[uint64]bool{1: true, 2: true, 4: true}, 3)
+		require.NoError(t, err)
+		require.Equal(t, []uint64{4}, versions(plan))
+	})
+	t.Run("Irreversible", func(t *testing.T) {
+		_, err := planDown(migrations, map[uint64]bool{1: true, 2: true, 3: true}, 0)
+		require.True(t, errors.Is(err, ErrIrreversible))
+	})
+}
+
+type renewLocker struct {
+	renewals int
+	renewErr error
+	unlocked bool
+}
+
+func (l *renewLocker) Lock(context.Context) error { return nil }
+
+func (l *renewLocker) Unlock(context.Context) error {
+	l.unlocked = true
+	return nil
+}
+
+func (l *renewLocker) Renew(context.Context) error {
+	l.renewals++
+	if l.renewals > 2 {
+		return l.renewErr
+	}
+	return nil
+}
+
+func TestLockedRenew(t *testing.T) {
+	t.Run("Renewed", func(t *testing.T) {
+		l := &renewLocker{}
+		m := &Migrator{locker: l, renewEvery: time.Millisecond}
+		err := m.locked(context.Background(), func(ctx context.Context) error {
+			time.Sleep(10 * time.Millisecond)
+			return ctx.Err()
+		})
+		require.NoError(t, err)
+		require.True(t, l.unlocked)
+		require.Positive(t, l.renewals)
+	})
+	t.Run("Lost", func(t *testing.T) {
+		l := &renewLocker{renewErr: errors.New("lock row not found")}
+		m := &Migrator{locker: l, renewEvery: time.Millisecond}
+		err := m.locked(context.Background(), func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		})
+		require.ErrorIs(t, err, ErrLockLost)
+		require.True(t, l.unlocked)
+		require.Equal(t, 3, l.renewals)
+	})
+}
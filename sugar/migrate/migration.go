@@ -0,0 +1,203 @@
+package migrate
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+)
+
+const (
+	upSuffix   = ".up"
+	downSuffix = ".down"
+)
+
+// Step is a single direction (up or down) of migration
+//
+// Step runs inside table.Client.Do retry loop, so it must be ready to be called more than once
+type Step func(ctx context.Context, s table.Session) error
+
+// Migration describes one versioned schema change
+type Migration struct {
+	// Version is a unique positive identifier of migration. Migrations applies in ascending order of versions
+	Version uint64
+
+	// Name is a human-readable description of migration
+	Name string
+
+	// Up applies migration
+	Up Step
+
+	// Down reverts migration. Nil Down means that migration is irreversible
+	Down Step
+}
+
+// Func makes migration from go functions
+func Func(version uint64, name string, up, down Step) Migration {
+	return Migration{
+		Version: version,
+		Name:    name,
+		Up:      up,
+		Down:    down,
+	}
+}
+
+// Query makes migration from YQL texts which executes with table.Session.ExecuteSchemeQuery
+// Empty down means that migration is irreversible
+func Query(version uint64, name string, up, down string) Migration {
+	return Migration{
+		Version: version,
+		Name:    name,
+		Up:      schemeQueryStep(up),
+		Down:    schemeQueryStep(down),
+	}
+}
+
+func schemeQueryStep(query string) Step {
+	if strings.TrimSpace(query) == "" {
+		return nil
+	}
+	return func(ctx context.Context, s table.Session) error {
+		return s.ExecuteSchemeQuery(ctx, query)
+	}
+}
+
+// FromFS reads migrations from directory dir of file system fsys
+//
+// Each migration is a pair of files named as `<version>_<name>.up.<ext>` and `<version>_<name>.down.<ext>`
+// (for example `0001_create_users.up.sql` and `0001_create_users.down.sql`).
+// Down file is optional. Files content executes with table.Session.ExecuteSchemeQuery
+func FromFS(fsys fs.FS, dir string) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	byVersion := make(map[uint64]*Migration)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		version, name, up, ok := parseFileName(entry.Name())
+		if !ok {
+			continue
+		}
+		content, err := fs.ReadFile(fsys, path.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		m, has := byVersion[version]
+		if !has {
+			m = &Migration{
+				Version: version,
+				Name:    name,
+			}
+			byVersion[version] = m
+		}
+		if m.Name != name {
+			return nil, xerrors.WithStackTrace(fmt.Errorf(
+				"migration version %d has different names: %q and %q", version, m.Name, name,
+			))
+		}
+		if up {
+			if m.Up != nil {
+				return nil, xerrors.WithStackTrace(fmt.Errorf("duplicate up migration for version %d", version))
+			}
+			m.Up = schemeQueryStep(string(content))
+		} else {
+			if m.Down != nil {
+				return nil, xerrors.WithStackTrace(fmt.Errorf("duplicate down migration for version %d", version))
+			}
+			m.Down = schemeQueryStep(string(content))
+		}
+	}
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == nil {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("migration version %d has no up file", m.Version))
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations, nil
+}
+
+// parseFileName parses file name like `0001_create_users.up.sql`
+func parseFileName(fileName string) (version uint64, name string, up bool, ok bool) {
+	base := strings.TrimSuffix(fileName, path.Ext(fileName))
+	switch {
+	case strings.HasSuffix(base, upSuffix):
+		up, base = true, strings.TrimSuffix(base, upSuffix)
+	case strings.HasSuffix(base, downSuffix):
+		up, base = false, strings.TrimSuffix(base, downSuffix)
+	default:
+		return 0, "", false, false
+	}
+	versionPart, name := base, ""
+	if i := strings.IndexByte(base, '_'); i >= 0 {
+		versionPart, name = base[:i], base[i+1:]
+	}
+	version, err := strconv.ParseUint(versionPart, 10, 64)
+	if err != nil || version == 0 {
+		return 0, "", false, false
+	}
+	return version, name, up, true
+}
+
+func validate(migrations []Migration) ([]Migration, error) {
+	sorted := make([]Migration, len(migrations))
+	copy(sorted, migrations)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Version < sorted[j].Version
+	})
+	for i, m := range sorted {
+		if m.Version == 0 {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("migration %q has zero version", m.Name))
+		}
+		if m.Up == nil {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("migration version %d has no up step", m.Version))
+		}
+		if i > 0 && sorted[i-1].Version == m.Version {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("duplicate migration version %d", m.Version))
+		}
+	}
+	return sorted, nil
+}
+
+// planUp returns not applied migrations with version less or equal than target in ascending order
+func planUp(migrations []Migration, applied map[uint64]bool, target uint64) (plan []Migration) {
+	for _, m := range migrations {
+		if m.Version > target {
+			break
+		}
+		if !applied[m.Version] {
+			plan = append(plan, m)
+		}
+	}
+	return plan
+}
+
+// planDown returns applied migrations with version greater than target in descending order
+func planDown(migrations []Migration, applied map[uint64]bool, target uint64) (plan []Migration, _ error) {
+	for i := len(migrations) - 1; i >= 0; i-- {
+		m := migrations[i]
+		if m.Version <= target {
+			break
+		}
+		if !applied[m.Version] {
+			continue
+		}
+		if m.Down == nil {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("%w: version %d", ErrIrreversible, m.Version))
+		}
+		plan = append(plan, m)
+	}
+	return plan, nil
+}
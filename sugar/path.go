@@ -19,11 +19,37 @@ const (
 	sysTable = ".sys"
 )
 
+type pathOptions struct {
+	retryPolicy retry.Policy
+}
+
+// PathOption configures MakeRecursive and RemoveRecursive
+type PathOption func(o *pathOptions)
+
+// WithRetryPolicy replaces default retry policy of scheme calls and
+// retry policy of table client for table calls
+func WithRetryPolicy(p retry.Policy) PathOption {
+	return func(o *pathOptions) {
+		o.retryPolicy = p
+	}
+}
+
+func newPathOptions(opts ...PathOption) *pathOptions {
+	o := &pathOptions{}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(o)
+		}
+	}
+	return o
+}
+
 // MakeRecursive creates path inside database
 // pathToCreate is a database root relative path
 // MakeRecursive method equal bash command `mkdir -p ~/path/to/create`
 // where `~` - is a root of database
-func MakeRecursive(ctx context.Context, db ydb.Connection, pathToCreate string) error {
+func MakeRecursive(ctx context.Context, db ydb.Connection, pathToCreate string, opts ...PathOption) error {
+	o := newPathOptions(opts...)
 	pathToCreate = path.Join(db.Name(), pathToCreate)
 	for i := len(db.Name()) + 1; i < len(pathToCreate); i++ {
 		x := strings.IndexByte(pathToCreate[i:], '/')
@@ -39,18 +65,18 @@ func MakeRecursive(ctx context.Context, db ydb.Connection, pathToCreate string)
 		err = retry.Retry(ctx, func(ctx context.Context) (err error) {
 			info, err = db.Scheme().DescribePath(ctx, sub)
 			return err
-		}, retry.WithIdempotent(true))
+		}, retry.WithIdempotent(true), retry.WithPolicy(o.retryPolicy))
 		if ydb.IsOperationError(err, Ydb.StatusIds_SCHEME_ERROR) {
 			err = retry.Retry(ctx, func(ctx context.Context) (err error) {
 				return db.Scheme().MakeDirectory(ctx, sub)
-			}, retry.WithIdempotent(true))
+			}, retry.WithIdempotent(true), retry.WithPolicy(o.retryPolicy))
 			if err != nil {
 				return xerrors.WithStackTrace(err)
 			}
 			err = retry.Retry(ctx, func(ctx context.Context) (err error) {
 				info, err = db.Scheme().DescribePath(ctx, sub)
 				return err
-			}, retry.WithIdempotent(true))
+			}, retry.WithIdempotent(true), retry.WithPolicy(o.retryPolicy))
 			if err != nil {
 				return xerrors.WithStackTrace(err)
 			}
@@ -78,7 +104,8 @@ func MakeRecursive(ctx context.Context, db ydb.Connection, pathToCreate string)
 // Empty prefix means than use root of database.
 // RemoveRecursive method equal bash command `rm -rf ~/path/to/remove`
 // where `~` - is a root of database
-func RemoveRecursive(ctx context.Context, db ydb.Connection, pathToRemove string) error {
+func RemoveRecursive(ctx context.Context, db ydb.Connection, pathToRemove string, opts ...PathOption) error {
+	o := newPathOptions(opts...)
 	fullSysTablePath := path.Join(db.Name(), sysTable)
 	var list func(int, string) error
 	list = func(i int, p string) error {
@@ -87,7 +114,7 @@ func RemoveRecursive(ctx context.Context, db ydb.Connection, pathToRemove string
 		err = retry.Retry(ctx, func(ctx context.Context) (err error) {
 			dir, err = db.Scheme().ListDirectory(ctx, p)
 			return xerrors.WithStackTrace(err)
-		}, retry.WithIdempotent(true))
+		}, retry.WithIdempotent(true), retry.WithPolicy(o.retryPolicy))
 		if ydb.IsOperationErrorSchemeError(err) {
 			return nil
 		}
@@ -107,7 +134,7 @@ func RemoveRecursive(ctx context.Context, db ydb.Connection, pathToRemove string
 				}
 				err = retry.Retry(ctx, func(ctx context.Context) (err error) {
 					return db.Scheme().RemoveDirectory(ctx, pt)
-				}, retry.WithIdempotent(true))
+				}, retry.WithIdempotent(true), retry.WithPolicy(o.retryPolicy))
 				if err != nil {
 					return xerrors.WithStackTrace(err)
 				}
@@ -115,7 +142,7 @@ func RemoveRecursive(ctx context.Context, db ydb.Connection, pathToRemove string
 			case scheme.EntryTable:
 				err = db.Table().Do(ctx, func(ctx context.Context, session table.Session) (err error) {
 					return session.DropTable(ctx, pt)
-				})
+				}, table.WithRetryPolicy(o.retryPolicy))
 				if err != nil {
 					return xerrors.WithStackTrace(err)
 				}
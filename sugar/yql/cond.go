@@ -0,0 +1,171 @@
+package yql
+
+import (
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+// Cond is a boolean expression of WHERE clause
+type Cond interface {
+	writeTo(w *writer)
+}
+
+type compareCond struct {
+	column string
+	op     string
+	value  types.Value
+}
+
+func (c compareCond) writeTo(w *writer) {
+	w.identifier(c.column)
+	w.write(" ", c.op, " ")
+	w.bind(c.value)
+}
+
+// Eq makes condition `column = $value`
+func Eq(column string, value types.Value) Cond {
+	return compareCond{column: column, op: "=", value: value}
+}
+
+// Ne makes condition `column != $value`
+func Ne(column string, value types.Value) Cond {
+	return compareCond{column: column, op: "!=", value: value}
+}
+
+// Lt makes condition `column < $value`
+func Lt(column string, value types.Value) Cond {
+	return compareCond{column: column, op: "<", value: value}
+}
+
+// Le makes condition `column <= $value`
+func Le(column string, value types.Value) Cond {
+	return compareCond{column: column, op: "<=", value: value}
+}
+
+// Gt makes condition `column > $value`
+func Gt(column string, value types.Value) Cond {
+	return compareCond{column: column, op: ">", value: value}
+}
+
+// Ge makes condition `column >= $value`
+func Ge(column string, value types.Value) Cond {
+	return compareCond{column: column, op: ">=", value: value}
+}
+
+// Like makes condition `column LIKE $pattern`
+func Like(column string, pattern types.Value) Cond {
+	return compareCond{column: column, op: "LIKE", value: pattern}
+}
+
+// In makes condition `column IN $list`. List must be a types.ListValue
+func In(column string, list types.Value) Cond {
+	return compareCond{column: column, op: "IN", value: list}
+}
+
+type nullCond struct {
+	column string
+	not    bool
+}
+
+func (c nullCond) writeTo(w *writer) {
+	w.identifier(c.column)
+	if c.not {
+		w.write(" IS NOT NULL")
+	} else {
+		w.write(" IS NULL")
+	}
+}
+
+// IsNull makes condition `column IS NULL`
+func IsNull(column string) Cond {
+	return nullCond{column: column}
+}
+
+// IsNotNull makes condition `column IS NOT NULL`
+func IsNotNull(column string) Cond {
+	return nullCond{column: column, not: true}
+}
+
+type betweenCond struct {
+	column   string
+	from, to types.Value
+}
+
+func (c betweenCond) writeTo(w *writer) {
+	w.identifier(c.column)
+	w.write(" BETWEEN ")
+	w.bind(c.from)
+	w.write(" AND ")
+	w.bind(c.to)
+}
+
+// Between makes condition `column BETWEEN $from AND $to`
+func Between(column string, from, to types.Value) Cond {
+	return betweenCond{column: column, from: from, to: to}
+}
+
+type logicalCond struct {
+	op    string
+	conds []Cond
+}
+
+func (c logicalCond) writeTo(w *writer) {
+	switch len(c.conds) {
+	case 0:
+		if c.op == "AND" {
+			w.write("TRUE")
+		} else {
+			w.write("FALSE")
+		}
+		return
+	case 1:
+		c.conds[0].writeTo(w)
+		return
+	}
+	for i, cond := range c.conds {
+		if i > 0 {
+			w.write(" ", c.op, " ")
+		}
+		w.write("(")
+		cond.writeTo(w)
+		w.write(")")
+	}
+}
+
+// And makes conjunction of conditions
+func And(conds ...Cond) Cond {
+	return logicalCond{op: "AND", conds: conds}
+}
+
+// Or makes disjunction of conditions
+func Or(conds ...Cond) Cond {
+	return logicalCond{op: "OR", conds: conds}
+}
+
+type notCond struct {
+	cond Cond
+}
+
+func (c notCond) writeTo(w *writer) {
+	w.write("NOT (")
+	c.cond.writeTo(w)
+	w.write(")")
+}
+
+// Not makes negation of condition
+func Not(cond Cond) Cond {
+	return notCond{cond: cond}
+}
+
+// writeWhere writes WHERE clause if conditions defined
+func writeWhere(w *writer, conds []Cond) {
+	switch len(conds) {
+	case 0:
+		return
+	case 1:
+		w.write("\nWHERE ")
+		conds[0].writeTo(w)
+	default:
+		w.write("\nWHERE ")
+		And(conds...).writeTo(w)
+	}
+}
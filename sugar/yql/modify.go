@@ -0,0 +1,165 @@
+package yql
+
+import (
+	"fmt"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+// InsertQuery is a builder of UPSERT or REPLACE query
+type InsertQuery struct {
+	op      string
+	table   string
+	columns []string
+	rows    [][]types.Value
+}
+
+// Upsert starts UPSERT query into table
+func Upsert(tablePath string) *InsertQuery {
+	return &InsertQuery{
+		op:    "UPSERT",
+		table: tablePath,
+	}
+}
+
+// Replace starts REPLACE query into table
+func Replace(tablePath string) *InsertQuery {
+	return &InsertQuery{
+		op:    "REPLACE",
+		table: tablePath,
+	}
+}
+
+// Columns defines columns of inserted rows
+func (q *InsertQuery) Columns(columns ...string) *InsertQuery {
+	q.columns = columns
+	return q
+}
+
+// Values appends row with values in order of columns
+func (q *InsertQuery) Values(values ...types.Value) *InsertQuery {
+	q.rows = append(q.rows, values)
+	return q
+}
+
+// Build returns query text with DECLARE section and parameters for query execution
+func (q *InsertQuery) Build(opts ...BuildOption) (string, *table.QueryParameters, error) {
+	return build(q, opts...)
+}
+
+func (q *InsertQuery) writeTo(w *writer) {
+	w.write(q.op, " INTO ")
+	w.table(q.table)
+	w.write(" (")
+	w.identifiers(q.columns)
+	w.write(")\nVALUES")
+	if len(q.rows) == 0 || len(q.columns) == 0 {
+		w.fail(ErrNoValues)
+		return
+	}
+	for i, row := range q.rows {
+		if len(row) != len(q.columns) {
+			w.fail(fmt.Errorf("%w: row %d has %d values, but %d columns defined",
+				ErrColumnsMismatch, i, len(row), len(q.columns),
+			))
+			return
+		}
+		if i > 0 {
+			w.write(",")
+		}
+		w.write("\n    (")
+		for j, v := range row {
+			if j > 0 {
+				w.write(", ")
+			}
+			w.bind(v)
+		}
+		w.write(")")
+	}
+}
+
+type assignment struct {
+	column string
+	value  types.Value
+}
+
+// UpdateQuery is a builder of UPDATE query
+type UpdateQuery struct {
+	table string
+	set   []assignment
+	where []Cond
+}
+
+// Update starts UPDATE query of table
+func Update(tablePath string) *UpdateQuery {
+	return &UpdateQuery{
+		table: tablePath,
+	}
+}
+
+// Set appends assignment of column value
+func (q *UpdateQuery) Set(column string, value types.Value) *UpdateQuery {
+	q.set = append(q.set, assignment{column: column, value: value})
+	return q
+}
+
+// Where appends conditions of WHERE clause. Multiple conditions are joined with AND
+func (q *UpdateQuery) Where(conds ...Cond) *UpdateQuery {
+	q.where = append(q.where, conds...)
+	return q
+}
+
+// Build returns query text with DECLARE section and parameters for query execution
+func (q *UpdateQuery) Build(opts ...BuildOption) (string, *table.QueryParameters, error) {
+	return build(q, opts...)
+}
+
+func (q *UpdateQuery) writeTo(w *writer) {
+	w.write("UPDATE ")
+	w.table(q.table)
+	w.write("\nSET ")
+	if len(q.set) == 0 {
+		w.fail(ErrNoValues)
+		return
+	}
+	for i, s := range q.set {
+		if i > 0 {
+			w.write(", ")
+		}
+		w.identifier(s.column)
+		w.write(" = ")
+		w.bind(s.value)
+	}
+	writeWhere(w, q.where)
+}
+
+// DeleteQuery is a builder of DELETE query
+type DeleteQuery struct {
+	table string
+	where []Cond
+}
+
+// Delete starts DELETE query from table
+func Delete(tablePath string) *DeleteQuery {
+	return &DeleteQuery{
+		table: tablePath,
+	}
+}
+
+// Where appends conditions of WHERE clause. Multiple conditions are joined with AND
+func (q *DeleteQuery) Where(conds ...Cond) *DeleteQuery {
+	q.where = append(q.where, conds...)
+	return q
+}
+
+// Build returns query text with DECLARE section and parameters for query execution
+func (q *DeleteQuery) Build(opts ...BuildOption) (string, *table.QueryParameters, error) {
+	return build(q, opts...)
+}
+
+func (q *DeleteQuery) writeTo(w *writer) {
+	w.write("DELETE FROM ")
+	w.table(q.table)
+	writeWhere(w, q.where)
+}
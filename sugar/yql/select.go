@@ -0,0 +1,115 @@
+package yql
+
+import (
+	"strconv"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+)
+
+type orderBy struct {
+	column string
+	desc   bool
+}
+
+// SelectQuery is a builder of SELECT query
+type SelectQuery struct {
+	columns []string
+	table   string
+	view    string
+	where   []Cond
+	orderBy []orderBy
+	limit   uint64
+	offset  uint64
+}
+
+// Select starts SELECT query of columns. Empty columns means all columns (`SELECT *`)
+func Select(columns ...string) *SelectQuery {
+	return &SelectQuery{
+		columns: columns,
+	}
+}
+
+// From defines table path of query
+func (q *SelectQuery) From(tablePath string) *SelectQuery {
+	q.table = tablePath
+	return q
+}
+
+// View defines secondary index which uses for reading (`FROM table VIEW index`)
+func (q *SelectQuery) View(index string) *SelectQuery {
+	q.view = index
+	return q
+}
+
+// Where appends conditions of WHERE clause. Multiple conditions are joined with AND
+func (q *SelectQuery) Where(conds ...Cond) *SelectQuery {
+	q.where = append(q.where, conds...)
+	return q
+}
+
+// OrderBy appends columns of ORDER BY clause in ascending order
+func (q *SelectQuery) OrderBy(columns ...string) *SelectQuery {
+	for _, c := range columns {
+		q.orderBy = append(q.orderBy, orderBy{column: c})
+	}
+	return q
+}
+
+// OrderByDesc appends columns of ORDER BY clause in descending order
+func (q *SelectQuery) OrderByDesc(columns ...string) *SelectQuery {
+	for _, c := range columns {
+		q.orderBy = append(q.orderBy, orderBy{column: c, desc: true})
+	}
+	return q
+}
+
+// Limit defines LIMIT clause
+func (q *SelectQuery) Limit(limit uint64) *SelectQuery {
+	q.limit = limit
+	return q
+}
+
+// Offset defines OFFSET clause. Offset applies only with Limit
+func (q *SelectQuery) Offset(offset uint64) *SelectQuery {
+	q.offset = offset
+	return q
+}
+
+// Build returns query text with DECLARE section and parameters for query execution
+func (q *SelectQuery) Build(opts ...BuildOption) (string, *table.QueryParameters, error) {
+	return build(q, opts...)
+}
+
+func (q *SelectQuery) writeTo(w *writer) {
+	w.write("SELECT ")
+	if len(q.columns) == 0 {
+		w.write("*")
+	} else {
+		w.identifiers(q.columns)
+	}
+	w.write("\nFROM ")
+	w.table(q.table)
+	if q.view != "" {
+		w.write(" VIEW ")
+		w.identifier(q.view)
+	}
+	writeWhere(w, q.where)
+	if len(q.orderBy) > 0 {
+		w.write("\nORDER BY ")
+		for i, o := range q.orderBy {
+			if i > 0 {
+				w.write(", ")
+			}
+			w.identifier(o.column)
+			if o.desc {
+				w.write(" DESC")
+			}
+		}
+	}
+	if q.limit > 0 {
+		w.write("\nLIMIT ", strconv.FormatUint(q.limit, 10))
+		if q.offset > 0 {
+			w.write(" OFFSET ", strconv.FormatUint(q.offset, 10))
+		}
+	}
+}
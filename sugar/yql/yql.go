@@ -0,0 +1,155 @@
+// Package yql provides type-safe builder of YQL queries
+//
+// Builder binds each value as a query parameter, generates DECLARE section for bound parameters
+// and returns query text with ready *table.QueryParameters:
+//
+//	query, params, err := yql.Select("id", "title").
+//		From("series").
+//		Where(yql.Eq("id", types.Uint64Value(1))).
+//		Build(yql.WithTablePathPrefix(db.Name()))
+//	if err != nil {
+//		return err
+//	}
+//	_, res, err := s.Execute(ctx, table.DefaultTxControl(), query, params)
+//
+// Warning: This is an experimental feature and could change at any time
+package yql
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+
+	internal "github.com/ydb-platform/ydb-go-sdk/v3/internal/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	internalYQL "github.com/ydb-platform/ydb-go-sdk/v3/internal/yql"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+var (
+	// ErrEmptyTable returns from Build if table of query is not defined
+	ErrEmptyTable = errors.New("table is not defined")
+
+	// ErrEmptyIdentifier returns from Build if column name is empty
+	ErrEmptyIdentifier = errors.New("empty identifier")
+
+	// ErrNilValue returns from Build if bound value is nil
+	ErrNilValue = errors.New("nil value")
+
+	// ErrNoValues returns from Build if query has no columns, rows or assignments
+	ErrNoValues = errors.New("no values defined")
+
+	// ErrColumnsMismatch returns from Build if count of values not equals to count of columns
+	ErrColumnsMismatch = errors.New("count of values not equals to count of columns")
+)
+
+// Query is a buildable YQL query
+type Query interface {
+	// Build returns query text with DECLARE section and parameters for query execution
+	Build(opts ...BuildOption) (query string, params *table.QueryParameters, err error)
+
+	writeTo(w *writer)
+}
+
+type buildOptions struct {
+	tablePathPrefix string
+}
+
+// BuildOption configures Build of query
+type BuildOption func(o *buildOptions)
+
+// WithTablePathPrefix resolves relative table paths of query relative to prefix
+// with PRAGMA TablePathPrefix. Usually prefix is a database name (ydb.Connection.Name())
+// or a directory inside database
+func WithTablePathPrefix(prefix string) BuildOption {
+	return func(o *buildOptions) {
+		o.tablePathPrefix = prefix
+	}
+}
+
+// QuoteIdentifier quotes name of column or table path with backticks
+func QuoteIdentifier(name string) string {
+	return internalYQL.QuoteIdentifier(name)
+}
+
+// writer accumulates query text, bound parameters and first error
+type writer struct {
+	buf    bytes.Buffer
+	params []table.ParameterOption
+	err    error
+}
+
+func (w *writer) fail(err error) {
+	if w.err == nil {
+		w.err = xerrors.WithStackTrace(err)
+	}
+}
+
+func (w *writer) write(s ...string) {
+	for _, ss := range s {
+		w.buf.WriteString(ss)
+	}
+}
+
+func (w *writer) identifier(name string) {
+	if name == "" {
+		w.fail(ErrEmptyIdentifier)
+	}
+	w.buf.WriteString(QuoteIdentifier(name))
+}
+
+func (w *writer) identifiers(names []string) {
+	for i, name := range names {
+		if i > 0 {
+			w.buf.WriteString(", ")
+		}
+		w.identifier(name)
+	}
+}
+
+func (w *writer) table(path string) {
+	if path == "" {
+		w.fail(ErrEmptyTable)
+	}
+	w.buf.WriteString(QuoteIdentifier(path))
+}
+
+// bind adds value as next query parameter and writes parameter name
+func (w *writer) bind(v types.Value) {
+	if v == nil {
+		w.fail(ErrNilValue)
+		return
+	}
+	name := "$p" + strconv.Itoa(len(w.params)+1)
+	w.params = append(w.params, table.ValueParam(name, v))
+	w.buf.WriteString(name)
+}
+
+func build(q Query, opts ...BuildOption) (string, *table.QueryParameters, error) {
+	o := buildOptions{}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&o)
+		}
+	}
+	w := writer{}
+	q.writeTo(&w)
+	if w.err != nil {
+		return "", nil, w.err
+	}
+	params := table.NewQueryParameters(w.params...)
+	declares, err := internal.GenerateDeclareSection(params)
+	if err != nil {
+		return "", nil, xerrors.WithStackTrace(err)
+	}
+	var buf bytes.Buffer
+	if o.tablePathPrefix != "" {
+		fmt.Fprintf(&buf, "PRAGMA TablePathPrefix(%s);\n", strconv.Quote(o.tablePathPrefix))
+	}
+	buf.WriteString(declares)
+	buf.Write(w.buf.Bytes())
+	buf.WriteString(";\n")
+	return buf.String(), params, nil
+}
@@ -0,0 +1,168 @@
+package yql
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+func TestBuild(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		query  Query
+		opts   []BuildOption
+		text   string
+		params map[string]types.Value
+		err    error
+	}{
+		{
+			name:  "select all",
+			query: Select().From("series"),
+			text:  "SELECT *\nFROM `series`;\n",
+		},
+		{
+			name: "select",
+			query: Select("id", "title").
+				From("dir/series").
+				View("title_index").
+				Where(Eq("title", types.UTF8Value("IT Crowd")), Not(IsNull("id"))).
+				OrderBy("title").
+				OrderByDesc("id").
+				Limit(10).
+				Offset(20),
+			opts: []BuildOption{WithTablePathPrefix("/local")},
+			text: "PRAGMA TablePathPrefix(\"/local\");\n" +
+				"DECLARE $p1 AS Utf8;\n" +
+				"SELECT `id`, `title`\n" +
+				"FROM `dir/series` VIEW `title_index`\n" +
+				"WHERE (`title` = $p1) AND (NOT (`id` IS NULL))\n" +
+				"ORDER BY `title`, `id` DESC\n" +
+				"LIMIT 10 OFFSET 20;\n",
+			params: map[string]types.Value{
+				"$p1": types.UTF8Value("IT Crowd"),
+			},
+		},
+		{
+			name: "select or in",
+			query: Select("id").From("series").Where(Or(
+				In("id", types.ListValue(types.Uint64Value(1), types.Uint64Value(2))),
+				Between("id", types.Uint64Value(10), types.Uint64Value(20)),
+			)),
+			text: "DECLARE $p1 AS List<Uint64>;\n" +
+				"DECLARE $p2 AS Uint64;\n" +
+				"DECLARE $p3 AS Uint64;\n" +
+				"SELECT `id`\n" +
+				"FROM `series`\n" +
+				"WHERE (`id` IN $p1) OR (`id` BETWEEN $p2 AND $p3);\n",
+			params: map[string]types.Value{
+				"$p1": types.ListValue(types.Uint64Value(1), types.Uint64Value(2)),
+				"$p2": types.Uint64Value(10),
+				"$p3": types.Uint64Value(20),
+			},
+		},
+		{
+			name: "upsert",
+			query: Upsert("series").
+				Columns("id", "title").
+				Values(types.Uint64Value(1), types.UTF8Value("a")).
+				Values(types.Uint64Value(2), types.NullValue(types.TypeUTF8)),
+			text: "DECLARE $p1 AS Uint64;\n" +
+				"DECLARE $p2 AS Utf8;\n" +
+				"DECLARE $p3 AS Uint64;\n" +
+				"DECLARE $p4 AS Optional<Utf8>;\n" +
+				"UPSERT INTO `series` (`id`, `title`)\n" +
+				"VALUES\n" +
+				"    ($p1, $p2),\n" +
+				"    ($p3, $p4);\n",
+			params: map[string]types.Value{
+				"$p1": types.Uint64Value(1),
+				"$p2": types.UTF8Value("a"),
+				"$p3": types.Uint64Value(2),
+				"$p4": types.NullValue(types.TypeUTF8),
+			},
+		},
+		{
+			name:  "replace",
+			query: Replace("se`ries").Columns("id").Values(types.Uint64Value(1)),
+			text: "DECLARE $p1 AS Uint64;\n" +
+				"REPLACE INTO `se\\`ries` (`id`)\n" +
+				"VALUES\n" +
+				"    ($p1);\n",
+			params: map[string]types.Value{
+				"$p1": types.Uint64Value(1),
+			},
+		},
+		{
+			name: "update",
+			query: Update("series").
+				Set("title", types.UTF8Value("b")).
+				Where(Eq("id", types.Uint64Value(1))),
+			text: "DECLARE $p1 AS Utf8;\n" +
+				"DECLARE $p2 AS Uint64;\n" +
+				"UPDATE `series`\n" +
+				"SET `title` = $p1\n" +
+				"WHERE `id` = $p2;\n",
+			params: map[string]types.Value{
+				"$p1": types.UTF8Value("b"),
+				"$p2": types.Uint64Value(1),
+			},
+		},
+		{
+			name:  "delete",
+			query: Delete("series").Where(Gt("id", types.Uint64Value(1))),
+			text: "DECLARE $p1 AS Uint64;\n" +
+				"DELETE FROM `series`\n" +
+				"WHERE `id` > $p1;\n",
+			params: map[string]types.Value{
+				"$p1": types.Uint64Value(1),
+			},
+		},
+		{
+			name:  "empty table",
+			query: Select(),
+			err:   ErrEmptyTable,
+		},
+		{
+			name:  "empty identifier",
+			query: Select("").From("series"),
+			err:   ErrEmptyIdentifier,
+		},
+		{
+			name:  "nil value",
+			query: Delete("series").Where(Eq("id", nil)),
+			err:   ErrNilValue,
+		},
+		{
+			name:  "no values",
+			query: Update("series"),
+			err:   ErrNoValues,
+		},
+		{
+			name:  "columns mismatch",
+			query: Upsert("series").Columns("id", "title").Values(types.Uint64Value(1)),
+			err:   ErrColumnsMismatch,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			text, params, err := tt.query.Build(tt.opts...)
+			if tt.err != nil {
+				require.Error(t, err)
+				require.True(t, errors.Is(err, tt.err), err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.text, text)
+			got := make(map[string]types.Value)
+			params.Each(func(name string, v types.Value) {
+				got[name] = v
+			})
+			if tt.params == nil {
+				tt.params = map[string]types.Value{}
+			}
+			require.Equal(t, tt.params, got)
+		})
+	}
+}
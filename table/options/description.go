@@ -0,0 +1,185 @@
+package options
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/feature"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+const (
+	modelTag   = "ydb"
+	modelTagPK = "pk"
+)
+
+// NewDescription makes table description from create table options
+//
+// Settings which not defined with options (partitioning, storage, read replicas and other)
+// leaves with zero (unspecified) values
+func NewDescription(opts ...CreateTableOption) Description {
+	var (
+		request Ydb_Table.CreateTableRequest
+		a       = allocator.New()
+	)
+	defer a.Free()
+	for _, opt := range opts {
+		opt((*CreateTableDesc)(&request), a)
+	}
+	d := Description{
+		Name:                 request.GetPath(),
+		Columns:              make([]Column, len(request.GetColumns())),
+		PrimaryKey:           request.GetPrimaryKey(),
+		ColumnFamilies:       make([]ColumnFamily, len(request.GetColumnFamilies())),
+		Attributes:           make(map[string]string, len(request.GetAttributes())),
+		StorageSettings:      NewStorageSettings(request.GetStorageSettings()),
+		KeyBloomFilter:       feature.FromYDB(request.GetKeyBloomFilter()),
+		PartitioningSettings: NewPartitioningSettings(request.GetPartitioningSettings()),
+		Indexes:              make([]IndexDescription, len(request.GetIndexes())),
+		TimeToLiveSettings:   NewTimeToLiveSettings(request.GetTtlSettings()),
+	}
+	if rr := request.GetReadReplicasSettings(); rr != nil {
+		d.ReadReplicaSettings = NewReadReplicasSettings(rr)
+	}
+	for i, c := range request.GetColumns() {
+		d.Columns[i] = Column{
+			Name:   c.GetName(),
+			Type:   value.TypeFromYDB(c.GetType()),
+			Family: c.GetFamily(),
+		}
+	}
+	for i, c := range request.GetColumnFamilies() {
+		d.ColumnFamilies[i] = NewColumnFamily(c)
+	}
+	for k, v := range request.GetAttributes() {
+		d.Attributes[k] = v
+	}
+	for i, idx := range request.GetIndexes() {
+		var t IndexType
+		switch idx.GetType().(type) {
+		case *Ydb_Table.TableIndex_GlobalIndex:
+			t = GlobalIndex()
+		case *Ydb_Table.TableIndex_GlobalAsyncIndex:
+			t = GlobalAsyncIndex()
+		}
+		d.Indexes[i] = IndexDescription{
+			Name:         idx.GetName(),
+			IndexColumns: idx.GetIndexColumns(),
+			DataColumns:  idx.GetDataColumns(),
+			Type:         t,
+		}
+	}
+	return d
+}
+
+// NewDescriptionFromStruct makes table description from go struct model
+//
+// Each exported field of struct is a table column. Column name defines with `ydb` field tag
+// (field name uses if tag is empty). Tag `ydb:"id,pk"` marks column as a part of primary key
+// (primary key columns are ordered as fields in struct). Tag `ydb:"-"` skips field.
+// All columns are optional (as YDB table columns), so pointer fields maps to the same types as values
+func NewDescriptionFromStruct(model interface{}) (d Description, _ error) {
+	t := reflect.TypeOf(model)
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return d, xerrors.WithStackTrace(fmt.Errorf("model must be a struct, got %T", model))
+	}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			continue
+		}
+		tag := f.Tag.Get(modelTag)
+		if tag == "-" {
+			continue
+		}
+		name, flags := f.Name, ""
+		if tag != "" {
+			name, flags = tag, ""
+			if j := strings.IndexByte(tag, ','); j >= 0 {
+				name, flags = tag[:j], tag[j+1:]
+			}
+			if name == "" {
+				name = f.Name
+			}
+		}
+		typ, err := typeFromGo(f.Type)
+		if err != nil {
+			return d, xerrors.WithStackTrace(fmt.Errorf("field %q: %w", f.Name, err))
+		}
+		d.Columns = append(d.Columns, Column{
+			Name: name,
+			Type: types.Optional(typ),
+		})
+		for _, flag := range strings.Split(flags, ",") {
+			if strings.TrimSpace(flag) == modelTagPK {
+				d.PrimaryKey = append(d.PrimaryKey, name)
+			}
+		}
+	}
+	if len(d.PrimaryKey) == 0 {
+		return d, xerrors.WithStackTrace(fmt.Errorf("model %s has no primary key columns", t.Name()))
+	}
+	return d, nil
+}
+
+var (
+	typeTime     = reflect.TypeOf(time.Time{})
+	typeDuration = reflect.TypeOf(time.Duration(0))
+)
+
+func typeFromGo(t reflect.Type) (types.Type, error) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t {
+	case typeTime:
+		return types.TypeTimestamp, nil
+	case typeDuration:
+		return types.TypeInterval, nil
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return types.TypeBool, nil
+	case reflect.Int8:
+		return types.TypeInt8, nil
+	case reflect.Uint8:
+		return types.TypeUint8, nil
+	case reflect.Int16:
+		return types.TypeInt16, nil
+	case reflect.Uint16:
+		return types.TypeUint16, nil
+	case reflect.Int32:
+		return types.TypeInt32, nil
+	case reflect.Uint32:
+		return types.TypeUint32, nil
+	case reflect.Int, reflect.Int64:
+		return types.TypeInt64, nil
+	case reflect.Uint, reflect.Uint64:
+		return types.TypeUint64, nil
+	case reflect.Float32:
+		return types.TypeFloat, nil
+	case reflect.Float64:
+		return types.TypeDouble, nil
+	case reflect.String:
+		return types.TypeUTF8, nil
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return types.TypeString, nil
+		}
+	case reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 && t.Len() == 16 {
+			return types.TypeUUID, nil
+		}
+	}
+	return nil, xerrors.WithStackTrace(fmt.Errorf("unsupported go type %s", t))
+}
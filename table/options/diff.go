@@ -0,0 +1,452 @@
+package options
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+// ErrNotConvergeable returns from Diff when current table cannot be altered to desired state
+// (for example, on changing of primary key or column type)
+var ErrNotConvergeable = errors.New("table cannot be altered to desired description")
+
+type ChangeKind byte
+
+const (
+	ChangeAddColumn ChangeKind = iota
+	ChangeDropColumn
+	ChangeAddIndex
+	ChangeDropIndex
+	ChangeAddColumnFamily
+	ChangeAlterColumnFamily
+	ChangeSetTimeToLive
+	ChangeDropTimeToLive
+	ChangeAlterPartitioningSettings
+	ChangeAlterReadReplicasSettings
+	ChangeAlterStorageSettings
+	ChangeAlterKeyBloomFilter
+	ChangeAlterAttribute
+	ChangeDropAttribute
+)
+
+func (k ChangeKind) String() string {
+	switch k {
+	case ChangeAddColumn:
+		return "add column"
+	case ChangeDropColumn:
+		return "drop column"
+	case ChangeAddIndex:
+		return "add index"
+	case ChangeDropIndex:
+		return "drop index"
+	case ChangeAddColumnFamily:
+		return "add column family"
+	case ChangeAlterColumnFamily:
+		return "alter column family"
+	case ChangeSetTimeToLive:
+		return "set time to live"
+	case ChangeDropTimeToLive:
+		return "drop time to live"
+	case ChangeAlterPartitioningSettings:
+		return "alter partitioning settings"
+	case ChangeAlterReadReplicasSettings:
+		return "alter read replicas settings"
+	case ChangeAlterStorageSettings:
+		return "alter storage settings"
+	case ChangeAlterKeyBloomFilter:
+		return "alter key bloom filter"
+	case ChangeAlterAttribute:
+		return "alter attribute"
+	case ChangeDropAttribute:
+		return "drop attribute"
+	default:
+		return statusUnknown
+	}
+}
+
+// Change is a single alteration of table which found by Diff
+type Change struct {
+	Kind ChangeKind
+
+	// Name is a name of changed column, index, column family or attribute. Empty for table-wide settings
+	Name string
+
+	// Destructive marks changes which may lose data or break queries (drop of column or index,
+	// set of time to live settings which expires rows)
+	Destructive bool
+
+	Option AlterTableOption
+}
+
+func (c Change) String() string {
+	if c.Name == "" {
+		return c.Kind.String()
+	}
+	return c.Kind.String() + " " + c.Name
+}
+
+type Changes []Change
+
+// Options returns alter table options of all changes
+//
+// Note: YDB may reject some combinations of changes (for example, adding of index together with
+// other changes) in single AlterTable call. In this case apply options of changes one by one
+func (changes Changes) Options() []AlterTableOption {
+	opts := make([]AlterTableOption, len(changes))
+	for i, c := range changes {
+		opts[i] = c.Option
+	}
+	return opts
+}
+
+// Destructive returns destructive changes only
+func (changes Changes) Destructive() (destructive Changes) {
+	for _, c := range changes {
+		if c.Destructive {
+			destructive = append(destructive, c)
+		}
+	}
+	return destructive
+}
+
+type diffOptions struct {
+	dropTimeToLive bool
+	dropAttributes bool
+}
+
+// DiffOption is an option of Diff
+type DiffOption func(o *diffOptions)
+
+// WithDiffDropTimeToLive allows Diff to drop time to live settings of current table
+// if desired description has no time to live settings
+func WithDiffDropTimeToLive() DiffOption {
+	return func(o *diffOptions) {
+		o.dropTimeToLive = true
+	}
+}
+
+// WithDiffDropAttributes allows Diff to drop attributes of current table which desired description has not
+func WithDiffDropAttributes() DiffOption {
+	return func(o *diffOptions) {
+		o.dropAttributes = true
+	}
+}
+
+// Diff compares current (usually received from DescribeTable) and desired (usually made with NewDescription
+// or NewDescriptionFromStruct) table descriptions and returns changes for converging current table to desired
+//
+// Zero (unspecified) values of partitioning, storage, read replicas settings, key bloom filter and
+// time to live settings in desired description means "leave as is". Attributes of current table which
+// desired description has not are left as is too. Use WithDiffDropTimeToLive and WithDiffDropAttributes
+// options for dropping of them.
+// Diff returns ErrNotConvergeable if primary key, column type, column family of column or
+// definition of existing index differs
+func Diff(current, desired Description, opts ...DiffOption) (changes Changes, _ error) {
+	var o diffOptions
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&o)
+		}
+	}
+
+	if !stringsEqual(current.PrimaryKey, desired.PrimaryKey) {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: primary key %v differs from %v",
+			ErrNotConvergeable, current.PrimaryKey, desired.PrimaryKey,
+		))
+	}
+
+	columnChanges, err := diffColumns(current.Columns, desired.Columns)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	changes = append(changes, columnChanges...)
+	changes = append(changes, diffColumnFamilies(current.ColumnFamilies, desired.ColumnFamilies)...)
+	indexChanges, err := diffIndexes(current.Indexes, desired.Indexes)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	changes = append(changes, indexChanges...)
+	changes = append(changes, diffTimeToLive(current.TimeToLiveSettings, desired.TimeToLiveSettings, o.dropTimeToLive)...)
+	changes = append(changes, diffSettings(current, desired)...)
+	changes = append(changes, diffAttributes(current.Attributes, desired.Attributes, o.dropAttributes)...)
+
+	return changes, nil
+}
+
+func diffColumns(current, desired []Column) (changes Changes, _ error) {
+	currentColumns := make(map[string]Column, len(current))
+	for _, c := range current {
+		currentColumns[c.Name] = c
+	}
+	desiredColumns := make(map[string]struct{}, len(desired))
+	for _, c := range desired {
+		desiredColumns[c.Name] = struct{}{}
+		existing, has := currentColumns[c.Name]
+		if !has {
+			changes = append(changes, Change{
+				Kind:   ChangeAddColumn,
+				Name:   c.Name,
+				Option: WithAddColumnMeta(c),
+			})
+			continue
+		}
+		if !types.Equal(existing.Type, c.Type) {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("%w: type of column %q %s differs from %s",
+				ErrNotConvergeable, c.Name, existing.Type, c.Type,
+			))
+		}
+		if existing.Family != c.Family {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("%w: family of column %q %q differs from %q",
+				ErrNotConvergeable, c.Name, existing.Family, c.Family,
+			))
+		}
+	}
+	for _, c := range current {
+		if _, has := desiredColumns[c.Name]; !has {
+			changes = append(changes, Change{
+				Kind:        ChangeDropColumn,
+				Name:        c.Name,
+				Destructive: true,
+				Option:      WithDropColumn(c.Name),
+			})
+		}
+	}
+	return changes, nil
+}
+
+func diffColumnFamilies(current, desired []ColumnFamily) (changes Changes) {
+	currentFamilies := make(map[string]ColumnFamily, len(current))
+	for _, cf := range current {
+		currentFamilies[cf.Name] = cf
+	}
+	for _, cf := range desired {
+		existing, has := currentFamilies[cf.Name]
+		switch {
+		case !has:
+			changes = append(changes, Change{
+				Kind:   ChangeAddColumnFamily,
+				Name:   cf.Name,
+				Option: withAddColumnFamily(cf),
+			})
+		case existing != cf:
+			changes = append(changes, Change{
+				Kind:   ChangeAlterColumnFamily,
+				Name:   cf.Name,
+				Option: withAlterColumnFamily(cf),
+			})
+		}
+	}
+	return changes
+}
+
+// withAddColumnFamily appends column family unlike WithAddColumnFamilies which replaces all added families
+func withAddColumnFamily(cf ColumnFamily) AlterTableOption {
+	return func(d *AlterTableDesc, a *allocator.Allocator) {
+		d.AddColumnFamilies = append(d.AddColumnFamilies, cf.toYDB())
+	}
+}
+
+// withAlterColumnFamily appends column family into altered families of request
+func withAlterColumnFamily(cf ColumnFamily) AlterTableOption {
+	return func(d *AlterTableDesc, a *allocator.Allocator) {
+		d.AlterColumnFamilies = append(d.AlterColumnFamilies, cf.toYDB())
+	}
+}
+
+func stringsEqual(lhs, rhs []string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+	for i := range lhs {
+		if lhs[i] != rhs[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func indexType(idx IndexDescription) IndexType {
+	if idx.Type == nil {
+		return GlobalIndex()
+	}
+	return idx.Type
+}
+
+func indexesEqual(lhs, rhs IndexDescription) bool {
+	return indexType(lhs) == indexType(rhs) &&
+		stringsEqual(lhs.IndexColumns, rhs.IndexColumns) &&
+		stringsEqual(lhs.DataColumns, rhs.DataColumns)
+}
+
+// diffIndexes returns changes of indexes. Index with changed definition cannot be dropped and added
+// with same name in single AlterTable call, so Diff reports it as not convergeable
+func diffIndexes(current, desired []IndexDescription) (changes Changes, _ error) {
+	currentIndexes := make(map[string]IndexDescription, len(current))
+	for _, idx := range current {
+		currentIndexes[idx.Name] = idx
+	}
+	desiredIndexes := make(map[string]struct{}, len(desired))
+	for _, idx := range desired {
+		desiredIndexes[idx.Name] = struct{}{}
+		existing, has := currentIndexes[idx.Name]
+		if has && indexesEqual(existing, idx) {
+			continue
+		}
+		if has {
+			return nil, xerrors.WithStackTrace(fmt.Errorf(
+				"%w: definition of index %q differs (drop index or add index with other name)",
+				ErrNotConvergeable, idx.Name,
+			))
+		}
+		opts := []IndexOption{
+			WithIndexColumns(idx.IndexColumns...),
+		}
+		if len(idx.DataColumns) > 0 {
+			opts = append(opts, WithDataColumns(idx.DataColumns...))
+		}
+		if idx.Type != nil {
+			opts = append(opts, WithIndexType(idx.Type))
+		}
+		changes = append(changes, Change{
+			Kind:   ChangeAddIndex,
+			Name:   idx.Name,
+			Option: WithAddIndex(idx.Name, opts...),
+		})
+	}
+	for _, idx := range current {
+		if _, has := desiredIndexes[idx.Name]; !has {
+			changes = append(changes, Change{
+				Kind:        ChangeDropIndex,
+				Name:        idx.Name,
+				Destructive: true,
+				Option:      WithDropIndex(idx.Name),
+			})
+		}
+	}
+	return changes, nil
+}
+
+func diffTimeToLive(current, desired *TimeToLiveSettings, drop bool) Changes {
+	switch {
+	case desired == nil && (current == nil || !drop):
+		return nil
+	case desired == nil:
+		return Changes{{
+			Kind:   ChangeDropTimeToLive,
+			Option: WithDropTimeToLive(),
+		}}
+	case current != nil && reflect.DeepEqual(current.ToYDB(), desired.ToYDB()):
+		return nil
+	default:
+		return Changes{{
+			Kind:        ChangeSetTimeToLive,
+			Name:        desired.ColumnName,
+			Destructive: true,
+			Option:      WithSetTimeToLiveSettings(*desired),
+		}}
+	}
+}
+
+func diffSettings(current, desired Description) (changes Changes) {
+	ps := mergePartitioningSettings(current.PartitioningSettings, desired.PartitioningSettings)
+	if ps != current.PartitioningSettings {
+		changes = append(changes, Change{
+			Kind:   ChangeAlterPartitioningSettings,
+			Option: WithAlterPartitionSettingsObject(ps),
+		})
+	}
+	if rr := desired.ReadReplicaSettings; rr.Count != 0 && rr != current.ReadReplicaSettings {
+		changes = append(changes, Change{
+			Kind:   ChangeAlterReadReplicasSettings,
+			Option: WithAlterReadReplicasSettings(rr),
+		})
+	}
+	if ss := mergeStorageSettings(current.StorageSettings, desired.StorageSettings); ss != current.StorageSettings {
+		changes = append(changes, Change{
+			Kind:   ChangeAlterStorageSettings,
+			Option: WithAlterStorageSettings(ss),
+		})
+	}
+	if f := desired.KeyBloomFilter; f != FeatureFlag(0) && f != current.KeyBloomFilter {
+		changes = append(changes, Change{
+			Kind:   ChangeAlterKeyBloomFilter,
+			Option: WithAlterKeyBloomFilter(f),
+		})
+	}
+	return changes
+}
+
+func mergePartitioningSettings(current, desired PartitioningSettings) PartitioningSettings {
+	if desired.PartitioningBySize != FeatureFlag(0) {
+		current.PartitioningBySize = desired.PartitioningBySize
+	}
+	if desired.PartitionSizeMb != 0 {
+		current.PartitionSizeMb = desired.PartitionSizeMb
+	}
+	if desired.PartitioningByLoad != FeatureFlag(0) {
+		current.PartitioningByLoad = desired.PartitioningByLoad
+	}
+	if desired.MinPartitionsCount != 0 {
+		current.MinPartitionsCount = desired.MinPartitionsCount
+	}
+	if desired.MaxPartitionsCount != 0 {
+		current.MaxPartitionsCount = desired.MaxPartitionsCount
+	}
+	return current
+}
+
+func mergeStorageSettings(current, desired StorageSettings) StorageSettings {
+	if desired.TableCommitLog0.Media != "" {
+		current.TableCommitLog0 = desired.TableCommitLog0
+	}
+	if desired.TableCommitLog1.Media != "" {
+		current.TableCommitLog1 = desired.TableCommitLog1
+	}
+	if desired.External.Media != "" {
+		current.External = desired.External
+	}
+	if desired.StoreExternalBlobs != FeatureFlag(0) {
+		current.StoreExternalBlobs = desired.StoreExternalBlobs
+	}
+	return current
+}
+
+func diffAttributes(current, desired map[string]string, drop bool) (changes Changes) {
+	keys := make([]string, 0, len(desired))
+	for k := range desired {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if v, has := current[k]; !has || v != desired[k] {
+			changes = append(changes, Change{
+				Kind:   ChangeAlterAttribute,
+				Name:   k,
+				Option: WithAlterAttribute(k, desired[k]),
+			})
+		}
+	}
+	if !drop {
+		return changes
+	}
+	keys = keys[:0]
+	for k := range current {
+		if _, has := desired[k]; !has {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		changes = append(changes, Change{
+			Kind:   ChangeDropAttribute,
+			Name:   k,
+			Option: WithAlterAttribute(k, ""),
+		})
+	}
+	return changes
+}
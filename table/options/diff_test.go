@@ -0,0 +1,164 @@
+package options
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+func applyAlterOptions(opts ...AlterTableOption) *Ydb_Table.AlterTableRequest {
+	a := allocator.New()
+	defer a.Free()
+	var req Ydb_Table.AlterTableRequest
+	for _, opt := range opts {
+		opt((*AlterTableDesc)(&req), a)
+	}
+	return &req
+}
+
+func TestNewDescriptionFromStruct(t *testing.T) {
+	type user struct {
+		ID        uint64 `ydb:"id,pk"`
+		Name      *string
+		CreatedAt time.Time `ydb:"created_at"`
+		Skipped   int       `ydb:"-"`
+		hidden    int
+	}
+	d, err := NewDescriptionFromStruct(&user{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"id"}, d.PrimaryKey)
+	require.Len(t, d.Columns, 3)
+	require.Equal(t, "id", d.Columns[0].Name)
+	require.True(t, types.Equal(types.Optional(types.TypeUint64), d.Columns[0].Type))
+	require.Equal(t, "Name", d.Columns[1].Name)
+	require.True(t, types.Equal(types.Optional(types.TypeUTF8), d.Columns[1].Type))
+	require.Equal(t, "created_at", d.Columns[2].Name)
+	require.True(t, types.Equal(types.Optional(types.TypeTimestamp), d.Columns[2].Type))
+
+	_, err = NewDescriptionFromStruct(struct{ A int }{})
+	require.Error(t, err)
+	_, err = NewDescriptionFromStruct(struct {
+		A map[string]int `ydb:"a,pk"`
+	}{})
+	require.Error(t, err)
+}
+
+func TestDiff(t *testing.T) {
+	current := NewDescription(
+		WithColumn("id", types.Optional(types.TypeUint64)),
+		WithColumn("name", types.Optional(types.TypeUTF8)),
+		WithColumn("legacy", types.Optional(types.TypeUTF8)),
+		WithPrimaryKeyColumn("id"),
+		WithIndex("idx_legacy", WithIndexColumns("legacy")),
+		WithIndex("idx_name", WithIndexColumns("name"), WithIndexType(GlobalIndex())),
+		WithAttribute("owner", "team-a"),
+		WithAttribute("obsolete", "true"),
+		WithPartitioningSettings(WithMinPartitionsCount(1), WithPartitioningBySize(FeatureEnabled)),
+	)
+	t.Run("Equal", func(t *testing.T) {
+		changes, err := Diff(current, current)
+		require.NoError(t, err)
+		require.Empty(t, changes)
+	})
+	t.Run("Converge", func(t *testing.T) {
+		desired := NewDescription(
+			WithColumn("id", types.Optional(types.TypeUint64)),
+			WithColumn("name", types.Optional(types.TypeUTF8)),
+			WithColumn("email", types.Optional(types.TypeUTF8)),
+			WithPrimaryKeyColumn("id"),
+			WithIndex("idx_name", WithIndexColumns("name")),
+			WithIndex("idx_email", WithIndexColumns("email"), WithIndexType(GlobalAsyncIndex())),
+			WithAttribute("owner", "team-b"),
+			WithTimeToLiveSettings(TimeToLiveSettings{ColumnName: "name", ExpireAfterSeconds: 60}),
+			WithPartitioningSettings(WithMinPartitionsCount(10)),
+		)
+		changes, err := Diff(current, desired, WithDiffDropAttributes())
+		require.NoError(t, err)
+		var kinds []string
+		for _, c := range changes {
+			kinds = append(kinds, c.String())
+		}
+		require.Equal(t, []string{
+			"add column email",
+			"drop column legacy",
+			"add index idx_email",
+			"drop index idx_legacy",
+			"set time to live name",
+			"alter partitioning settings",
+			"alter attribute owner",
+			"drop attribute obsolete",
+		}, kinds)
+		var destructive []string
+		for _, c := range changes.Destructive() {
+			destructive = append(destructive, c.String())
+		}
+		require.Equal(t, []string{
+			"drop column legacy",
+			"drop index idx_legacy",
+			"set time to live name",
+		}, destructive)
+
+		req := applyAlterOptions(changes.Options()...)
+		require.Len(t, req.GetAddColumns(), 1)
+		require.Equal(t, []string{"legacy"}, req.GetDropColumns())
+		require.Len(t, req.GetAddIndexes(), 1)
+		require.NotNil(t, req.GetAddIndexes()[0].GetGlobalAsyncIndex())
+		require.Equal(t, []string{"idx_legacy"}, req.GetDropIndexes())
+		require.Equal(t, "name", req.GetSetTtlSettings().GetDateTypeColumn().GetColumnName())
+		require.Equal(t, uint64(10), req.GetAlterPartitioningSettings().GetMinPartitionsCount())
+		require.Equal(t, map[string]string{"owner": "team-b", "obsolete": ""}, req.GetAlterAttributes())
+	})
+	t.Run("LeaveAsIs", func(t *testing.T) {
+		current := NewDescription(
+			WithColumn("id", types.Optional(types.TypeUint64)),
+			WithColumn("created_at", types.Optional(types.TypeTimestamp)),
+			WithPrimaryKeyColumn("id"),
+			WithAttribute("owner", "team-a"),
+			WithTimeToLiveSettings(TimeToLiveSettings{ColumnName: "created_at", ExpireAfterSeconds: 60}),
+		)
+		desired := NewDescription(
+			WithColumn("id", types.Optional(types.TypeUint64)),
+			WithColumn("created_at", types.Optional(types.TypeTimestamp)),
+			WithPrimaryKeyColumn("id"),
+		)
+		changes, err := Diff(current, desired)
+		require.NoError(t, err)
+		require.Empty(t, changes)
+		changes, err = Diff(current, desired, WithDiffDropTimeToLive(), WithDiffDropAttributes())
+		require.NoError(t, err)
+		var kinds []string
+		for _, c := range changes {
+			kinds = append(kinds, c.String())
+		}
+		require.Equal(t, []string{"drop time to live", "drop attribute owner"}, kinds)
+	})
+	t.Run("NotConvergeable", func(t *testing.T) {
+		_, err := Diff(current, NewDescription(
+			WithColumn("id", types.Optional(types.TypeUint64)),
+			WithColumn("name", types.Optional(types.TypeUTF8)),
+			WithPrimaryKeyColumn("id", "name"),
+		))
+		require.True(t, errors.Is(err, ErrNotConvergeable))
+		_, err = Diff(current, NewDescription(
+			WithColumn("id", types.Optional(types.TypeUint64)),
+			WithColumn("name", types.Optional(types.TypeUint64)),
+			WithPrimaryKeyColumn("id"),
+		))
+		require.True(t, errors.Is(err, ErrNotConvergeable))
+		_, err = Diff(current, NewDescription(
+			WithColumn("id", types.Optional(types.TypeUint64)),
+			WithColumn("name", types.Optional(types.TypeUTF8)),
+			WithColumn("legacy", types.Optional(types.TypeUTF8)),
+			WithPrimaryKeyColumn("id"),
+			WithIndex("idx_legacy", WithIndexColumns("legacy", "name")),
+			WithIndex("idx_name", WithIndexColumns("name")),
+		))
+		require.True(t, errors.Is(err, ErrNotConvergeable))
+	})
+}
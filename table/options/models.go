@@ -64,7 +64,9 @@ func NewTableColumn(name string, typ types.Type, family string) Column {
 type IndexDescription struct {
 	Name         string
 	IndexColumns []string
+	DataColumns  []string
 	Status       Ydb_Table.TableIndexDescription_Status
+	Type         IndexType
 }
 
 //nolint:unused
@@ -72,10 +74,28 @@ func (i IndexDescription) toYDB() *Ydb_Table.TableIndexDescription {
 	return &Ydb_Table.TableIndexDescription{
 		Name:         i.Name,
 		IndexColumns: i.IndexColumns,
+		DataColumns:  i.DataColumns,
 		Status:       i.Status,
 	}
 }
 
+func NewIndexDescription(idx *Ydb_Table.TableIndexDescription) IndexDescription {
+	var t IndexType
+	switch idx.GetType().(type) {
+	case *Ydb_Table.TableIndexDescription_GlobalIndex:
+		t = GlobalIndex()
+	case *Ydb_Table.TableIndexDescription_GlobalAsyncIndex:
+		t = GlobalAsyncIndex()
+	}
+	return IndexDescription{
+		Name:         idx.GetName(),
+		IndexColumns: idx.GetIndexColumns(),
+		DataColumns:  idx.GetDataColumns(),
+		Status:       idx.GetStatus(),
+		Type:         t,
+	}
+}
+
 type Description struct {
 	Name                 string
 	Columns              []Column
@@ -4,6 +4,7 @@ import (
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/declare"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value/allocator"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
@@ -111,6 +112,12 @@ func WithIndexType(t IndexType) IndexOption {
 	}
 }
 
+func WithDataColumns(columns ...string) IndexOption {
+	return func(d *indexDesc) {
+		d.DataColumns = append(d.DataColumns, columns...)
+	}
+}
+
 func WithColumnFamilies(cf ...ColumnFamily) CreateTableOption {
 	return func(d *CreateTableDesc, a *allocator.Allocator) {
 		d.ColumnFamilies = make([]*Ydb_Table.ColumnFamily, len(cf))
@@ -449,6 +456,24 @@ func WithAlterColumnFamilies(cf ...ColumnFamily) AlterTableOption {
 	}
 }
 
+func WithAddIndex(name string, opts ...IndexOption) AlterTableOption {
+	return func(d *AlterTableDesc, a *allocator.Allocator) {
+		x := &Ydb_Table.TableIndex{
+			Name: name,
+		}
+		for _, opt := range opts {
+			opt((*indexDesc)(x))
+		}
+		d.AddIndexes = append(d.AddIndexes, x)
+	}
+}
+
+func WithDropIndex(name string) AlterTableOption {
+	return func(d *AlterTableDesc, a *allocator.Allocator) {
+		d.DropIndexes = append(d.DropIndexes, name)
+	}
+}
+
 func WithAlterReadReplicasSettings(rr ReadReplicasSettings) AlterTableOption {
 	return func(d *AlterTableDesc, a *allocator.Allocator) {
 		d.SetReadReplicasSettings = rr.ToYDB()
@@ -498,10 +523,22 @@ type (
 )
 
 type (
-	ExecuteDataQueryDesc   Ydb_Table.ExecuteDataQueryRequest
+	// ExecuteDataQueryDesc is a data query request with flags of options which are not
+	// transferred to server (such as WithAutoDeclare). Request itself is available as
+	// &desc.ExecuteDataQueryRequest
+	ExecuteDataQueryDesc struct {
+		Ydb_Table.ExecuteDataQueryRequest
+
+		autoDeclare bool
+	}
 	ExecuteDataQueryOption func(*ExecuteDataQueryDesc)
 )
 
+// AutoDeclare reports whether WithAutoDeclare option was applied to desc
+func (d *ExecuteDataQueryDesc) AutoDeclare() bool {
+	return d.autoDeclare
+}
+
 type (
 	CommitTransactionDesc   Ydb_Table.CommitTransactionRequest
 	CommitTransactionOption func(*CommitTransactionDesc)
@@ -555,6 +592,23 @@ func withQueryCachePolicy(opts ...QueryCachePolicyOption) ExecuteDataQueryOption
 	}
 }
 
+// WithAutoDeclare prepends query text with DECLARE section generated from types of query parameters
+//
+// Parameters which query declares itself are skipped. For prepared statements query text
+// already compiled, so option checks parameters against declared types of statement instead
+func WithAutoDeclare() ExecuteDataQueryOption {
+	return func(d *ExecuteDataQueryDesc) {
+		d.autoDeclare = true
+		if text, ok := d.Query.GetQuery().(*Ydb_Table.Query_YqlText); ok {
+			d.Query = &Ydb_Table.Query{
+				Query: &Ydb_Table.Query_YqlText{
+					YqlText: declare.Prepend(text.YqlText, d.Parameters),
+				},
+			}
+		}
+	}
+}
+
 func WithCommitCollectStatsModeNone() CommitTransactionOption {
 	return func(d *CommitTransactionDesc) {
 		d.CollectStats = Ydb_Table.QueryStatsCollection_STATS_COLLECTION_NONE
@@ -257,3 +257,46 @@ func TestAlterTableOptions(t *testing.T) {
 		}
 	}
 }
+
+func TestWithAutoDeclare(t *testing.T) {
+	a := allocator.New()
+	defer a.Free()
+	params := map[string]*Ydb.TypedValue{
+		"$a": value.ToYDB(types.Uint64Value(1), a),
+		"$b": value.ToYDB(types.OptionalValue(types.UTF8Value("b")), a),
+	}
+	{
+		desc := ExecuteDataQueryDesc{
+			ExecuteDataQueryRequest: Ydb_Table.ExecuteDataQueryRequest{
+				Query: &Ydb_Table.Query{
+					Query: &Ydb_Table.Query_YqlText{
+						YqlText: "DECLARE $b AS Optional<Utf8>;\nSELECT $a, $b;",
+					},
+				},
+				Parameters: params,
+			},
+		}
+		require.False(t, desc.AutoDeclare())
+		WithAutoDeclare()(&desc)
+		require.True(t, desc.AutoDeclare())
+		require.Equal(t,
+			"DECLARE $a AS Uint64;\nDECLARE $b AS Optional<Utf8>;\nSELECT $a, $b;",
+			desc.GetQuery().GetYqlText(),
+		)
+	}
+	{
+		desc := ExecuteDataQueryDesc{
+			ExecuteDataQueryRequest: Ydb_Table.ExecuteDataQueryRequest{
+				Query: &Ydb_Table.Query{
+					Query: &Ydb_Table.Query_Id{
+						Id: "id",
+					},
+				},
+				Parameters: params,
+			},
+		}
+		WithAutoDeclare()(&desc)
+		require.True(t, desc.AutoDeclare())
+		require.Equal(t, "id", desc.GetQuery().GetId())
+	}
+}
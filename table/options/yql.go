@@ -0,0 +1,201 @@
+package options
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/yql"
+)
+
+const yqlIndent = "    "
+
+// ToYQL renders table description as CREATE TABLE YQL statement
+//
+// Description.Name uses as table path. Statement contains columns, primary key, secondary indexes,
+// column families, time to live, partitioning, read replicas, key bloom filter and external blobs settings.
+// Table attributes and storage pools of commit logs cannot be defined with YQL, so they renders as comments
+// before statement and must be applied separately (for example, with WithAlterAttribute option)
+func (d Description) ToYQL() string {
+	var buf bytes.Buffer
+
+	if len(d.Attributes) > 0 {
+		keys := make([]string, 0, len(d.Attributes))
+		for k := range d.Attributes {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			fmt.Fprintf(&buf, "-- ATTRIBUTE %s = %s\n", strconv.Quote(k), strconv.Quote(d.Attributes[k]))
+		}
+	}
+
+	buf.WriteString("CREATE TABLE ")
+	buf.WriteString(yql.QuoteIdentifier(d.Name))
+	buf.WriteString(" (\n")
+
+	var definitions []string
+	for _, c := range d.Columns {
+		definitions = append(definitions, columnToYQL(c))
+	}
+	if len(d.PrimaryKey) > 0 {
+		definitions = append(definitions, "PRIMARY KEY ("+identifiersToYQL(d.PrimaryKey)+")")
+	}
+	for _, idx := range d.Indexes {
+		definitions = append(definitions, indexToYQL(idx))
+	}
+	for _, cf := range d.ColumnFamilies {
+		if yql, ok := columnFamilyToYQL(cf); ok {
+			definitions = append(definitions, yql)
+		}
+	}
+	for i, def := range definitions {
+		buf.WriteString(yqlIndent)
+		buf.WriteString(def)
+		if i < len(definitions)-1 {
+			buf.WriteByte(',')
+		}
+		buf.WriteByte('\n')
+	}
+	buf.WriteByte(')')
+
+	if settings := d.settingsToYQL(); len(settings) > 0 {
+		buf.WriteString("\nWITH (\n")
+		for i, s := range settings {
+			buf.WriteString(yqlIndent)
+			buf.WriteString(s)
+			if i < len(settings)-1 {
+				buf.WriteByte(',')
+			}
+			buf.WriteByte('\n')
+		}
+		buf.WriteByte(')')
+	}
+	buf.WriteString(";\n")
+
+	return buf.String()
+}
+
+func identifiersToYQL(names []string) string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = yql.QuoteIdentifier(name)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+func columnToYQL(c Column) string {
+	var buf bytes.Buffer
+	buf.WriteString(yql.QuoteIdentifier(c.Name))
+	buf.WriteByte(' ')
+	inner, optional := value.OptionalInnerType(c.Type)
+	value.WriteTypeStringTo(&buf, inner)
+	if !optional {
+		buf.WriteString(" NOT NULL")
+	}
+	if c.Family != "" {
+		buf.WriteString(" FAMILY ")
+		buf.WriteString(yql.QuoteIdentifier(c.Family))
+	}
+	return buf.String()
+}
+
+func indexToYQL(idx IndexDescription) string {
+	var buf bytes.Buffer
+	buf.WriteString("INDEX ")
+	buf.WriteString(yql.QuoteIdentifier(idx.Name))
+	switch idx.Type.(type) {
+	case globalAsyncIndex:
+		buf.WriteString(" GLOBAL ASYNC ON (")
+	default:
+		buf.WriteString(" GLOBAL ON (")
+	}
+	buf.WriteString(identifiersToYQL(idx.IndexColumns))
+	buf.WriteByte(')')
+	if len(idx.DataColumns) > 0 {
+		buf.WriteString(" COVER (")
+		buf.WriteString(identifiersToYQL(idx.DataColumns))
+		buf.WriteByte(')')
+	}
+	return buf.String()
+}
+
+func columnFamilyToYQL(cf ColumnFamily) (string, bool) {
+	var settings []string
+	if cf.Data.Media != "" {
+		settings = append(settings, "DATA = "+strconv.Quote(cf.Data.Media))
+	}
+	switch cf.Compression {
+	case ColumnFamilyCompressionNone:
+		settings = append(settings, `COMPRESSION = "off"`)
+	case ColumnFamilyCompressionLZ4:
+		settings = append(settings, `COMPRESSION = "lz4"`)
+	}
+	if len(settings) == 0 {
+		return "", false
+	}
+	return "FAMILY " + yql.QuoteIdentifier(cf.Name) + " (" + strings.Join(settings, ", ") + ")", true
+}
+
+func featureFlagToYQL(f FeatureFlag) string {
+	if f == FeatureEnabled {
+		return "ENABLED"
+	}
+	return "DISABLED"
+}
+
+func (d Description) settingsToYQL() (settings []string) {
+	ps := d.PartitioningSettings
+	if ps.PartitioningBySize != FeatureFlag(0) {
+		settings = append(settings, "AUTO_PARTITIONING_BY_SIZE = "+featureFlagToYQL(ps.PartitioningBySize))
+	}
+	if ps.PartitionSizeMb != 0 {
+		settings = append(settings, "AUTO_PARTITIONING_PARTITION_SIZE_MB = "+strconv.FormatUint(ps.PartitionSizeMb, 10))
+	}
+	if ps.PartitioningByLoad != FeatureFlag(0) {
+		settings = append(settings, "AUTO_PARTITIONING_BY_LOAD = "+featureFlagToYQL(ps.PartitioningByLoad))
+	}
+	if ps.MinPartitionsCount != 0 {
+		settings = append(settings,
+			"AUTO_PARTITIONING_MIN_PARTITIONS_COUNT = "+strconv.FormatUint(ps.MinPartitionsCount, 10),
+		)
+	}
+	if ps.MaxPartitionsCount != 0 {
+		settings = append(settings,
+			"AUTO_PARTITIONING_MAX_PARTITIONS_COUNT = "+strconv.FormatUint(ps.MaxPartitionsCount, 10),
+		)
+	}
+	if rr := d.ReadReplicaSettings; rr.Count != 0 {
+		az := "PER_AZ"
+		if rr.Type == ReadReplicasAnyAzReadReplicas {
+			az = "ANY_AZ"
+		}
+		settings = append(settings, fmt.Sprintf(`READ_REPLICAS_SETTINGS = "%s:%d"`, az, rr.Count))
+	}
+	if d.KeyBloomFilter != FeatureFlag(0) {
+		settings = append(settings, "KEY_BLOOM_FILTER = "+featureFlagToYQL(d.KeyBloomFilter))
+	}
+	if d.StorageSettings.StoreExternalBlobs != FeatureFlag(0) {
+		settings = append(settings, "STORE_EXTERNAL_BLOBS = "+featureFlagToYQL(d.StorageSettings.StoreExternalBlobs))
+	}
+	if ttl := d.TimeToLiveSettings; ttl != nil {
+		s := fmt.Sprintf(`TTL = Interval("PT%dS") ON %s`, ttl.ExpireAfterSeconds, yql.QuoteIdentifier(ttl.ColumnName))
+		if ttl.Mode == TimeToLiveModeValueSinceUnixEpoch && ttl.ColumnUnit != nil {
+			switch *ttl.ColumnUnit {
+			case TimeToLiveUnitSeconds:
+				s += " AS SECONDS"
+			case TimeToLiveUnitMilliseconds:
+				s += " AS MILLISECONDS"
+			case TimeToLiveUnitMicroseconds:
+				s += " AS MICROSECONDS"
+			case TimeToLiveUnitNanoseconds:
+				s += " AS NANOSECONDS"
+			}
+		}
+		settings = append(settings, s)
+	}
+	return settings
+}
@@ -0,0 +1,84 @@
+package options
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+func TestDescriptionToYQL(t *testing.T) {
+	unit := TimeToLiveUnitSeconds
+	d := Description{
+		Name: "/local/series",
+		Columns: []Column{
+			{Name: "series_id", Type: types.Optional(types.TypeUint64)},
+			{Name: "title", Type: types.Optional(types.TypeUTF8), Family: "large"},
+			{Name: "release_date", Type: types.Optional(types.TypeUint64)},
+			{Name: "amount", Type: types.Optional(types.DecimalType(22, 9))},
+		},
+		PrimaryKey: []string{"series_id"},
+		Indexes: []IndexDescription{
+			{Name: "idx_title", IndexColumns: []string{"title"}, Type: GlobalIndex()},
+			{Name: "idx_date", IndexColumns: []string{"release_date"}, DataColumns: []string{"title"}, Type: GlobalAsyncIndex()},
+		},
+		ColumnFamilies: []ColumnFamily{
+			{Name: "default"},
+			{Name: "large", Data: StoragePool{Media: "hdd"}, Compression: ColumnFamilyCompressionLZ4},
+		},
+		PartitioningSettings: PartitioningSettings{
+			PartitioningBySize: FeatureEnabled,
+			PartitionSizeMb:    512,
+			PartitioningByLoad: FeatureDisabled,
+			MinPartitionsCount: 2,
+		},
+		ReadReplicaSettings: ReadReplicasSettings{Type: ReadReplicasAnyAzReadReplicas, Count: 1},
+		KeyBloomFilter:      FeatureEnabled,
+		TimeToLiveSettings: &TimeToLiveSettings{
+			ColumnName:         "release_date",
+			Mode:               TimeToLiveModeValueSinceUnixEpoch,
+			ExpireAfterSeconds: 3600,
+			ColumnUnit:         &unit,
+		},
+		Attributes: map[string]string{
+			"owner": "team",
+		},
+	}
+	require.Equal(t, "-- ATTRIBUTE \"owner\" = \"team\"\n"+
+		"CREATE TABLE `/local/series` (\n"+
+		"    `series_id` Uint64,\n"+
+		"    `title` Utf8 FAMILY `large`,\n"+
+		"    `release_date` Uint64,\n"+
+		"    `amount` Decimal(22,9),\n"+
+		"    PRIMARY KEY (`series_id`),\n"+
+		"    INDEX `idx_title` GLOBAL ON (`title`),\n"+
+		"    INDEX `idx_date` GLOBAL ASYNC ON (`release_date`) COVER (`title`),\n"+
+		"    FAMILY `large` (DATA = \"hdd\", COMPRESSION = \"lz4\")\n"+
+		")\n"+
+		"WITH (\n"+
+		"    AUTO_PARTITIONING_BY_SIZE = ENABLED,\n"+
+		"    AUTO_PARTITIONING_PARTITION_SIZE_MB = 512,\n"+
+		"    AUTO_PARTITIONING_BY_LOAD = DISABLED,\n"+
+		"    AUTO_PARTITIONING_MIN_PARTITIONS_COUNT = 2,\n"+
+		"    READ_REPLICAS_SETTINGS = \"ANY_AZ:1\",\n"+
+		"    KEY_BLOOM_FILTER = ENABLED,\n"+
+		"    TTL = Interval(\"PT3600S\") ON `release_date` AS SECONDS\n"+
+		");\n", d.ToYQL())
+}
+
+func TestDescriptionToYQLNotNull(t *testing.T) {
+	d := Description{
+		Name: "t",
+		Columns: []Column{
+			{Name: "id", Type: types.TypeUint64},
+			{Name: "we`ird", Type: types.Optional(types.TypeString)},
+		},
+		PrimaryKey: []string{"id"},
+	}
+	require.Equal(t, "CREATE TABLE `t` (\n"+
+		"    `id` Uint64 NOT NULL,\n"+
+		"    `we\\`ird` String,\n"+
+		"    PRIMARY KEY (`id`)\n"+
+		");\n", d.ToYQL())
+}
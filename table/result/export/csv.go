@@ -0,0 +1,84 @@
+package export
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result"
+)
+
+// CSV writes rows of all result sets of res into w as CSV records.
+// Header with column names written before first row and repeated
+// after empty line if columns of next result set are different.
+// Container values (Struct, List, Tuple, Dict, Variant) are written as JSON strings.
+func CSV(ctx context.Context, w io.Writer, res result.BaseResult, opts ...Option) error {
+	o := newOptions(opts)
+	cw := csv.NewWriter(w)
+	cw.Comma = o.comma
+	if err := export(ctx, res, &csvWriter{
+		w:    cw,
+		opts: o,
+	}); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	cw.Flush()
+	return xerrors.WithStackTrace(cw.Error())
+}
+
+type csvWriter struct {
+	w    *csv.Writer
+	opts exportOptions
+
+	cols   []column
+	record []string
+	buf    []byte
+	wrote  bool
+}
+
+func (w *csvWriter) columns(columns []column) error {
+	if w.cols != nil && columnsEqual(w.cols, columns) {
+		return nil
+	}
+	w.cols = columns
+	w.record = make([]string, len(columns))
+	if !w.opts.header {
+		return nil
+	}
+	if w.wrote {
+		// empty record written as empty line
+		if err := w.w.Write(nil); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+	}
+	for i, c := range columns {
+		w.record[i] = c.name
+	}
+	w.wrote = true
+	return xerrors.WithStackTrace(w.w.Write(w.record))
+}
+
+func (w *csvWriter) row(values []*Ydb.TypedValue) (err error) {
+	for i, v := range values {
+		var ok bool
+		w.buf, ok, err = appendText(w.buf[:0], v.Type, v.Value)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		if ok {
+			w.record[i] = string(w.buf)
+		} else {
+			w.record[i] = w.opts.null
+		}
+	}
+	w.wrote = true
+	return xerrors.WithStackTrace(w.w.Write(w.record))
+}
+
+func (w *csvWriter) flush() error {
+	w.w.Flush()
+	return xerrors.WithStackTrace(w.w.Error())
+}
@@ -0,0 +1,161 @@
+// Package export streams rows of query results into CSV and JSON Lines formats.
+// Arrow IPC stream format is not supported yet.
+//
+// YDB types are mapped as follows:
+//   - Optional NULL values are written as null (CSV writes NULL values as configured null string)
+//   - Decimal values are written as strings with exact scale
+//   - Date values are written as "2006-01-02", Datetime and Timestamp values as RFC3339 strings in UTC
+//   - Interval values are written as Go duration strings such as "1h2m3.5s"
+//   - UUID values are written in canonical text form
+//   - Struct, List, Tuple, Dict and Variant values are written as nested JSON
+//
+// Rows are written as soon as they are read, so exporting of large stream results (such as scan queries)
+// requires bounded memory.
+//
+//	res, err := s.StreamExecuteScanQuery(ctx, "SELECT ...", nil)
+//	if err != nil {
+//	    return err
+//	}
+//	defer res.Close()
+//	err = export.CSV(ctx, os.Stdout, res)
+package export
+
+import (
+	"context"
+	"io"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result/indexed"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+type exportOptions struct {
+	comma  rune
+	header bool
+	null   string
+}
+
+// Option configures export of result
+type Option func(o *exportOptions)
+
+// WithComma defines CSV fields delimiter. Default is ','
+func WithComma(comma rune) Option {
+	return func(o *exportOptions) {
+		o.comma = comma
+	}
+}
+
+// WithoutHeader disables writing of CSV header with column names
+func WithoutHeader() Option {
+	return func(o *exportOptions) {
+		o.header = false
+	}
+}
+
+// WithNull defines CSV representation of NULL values. Default is empty string
+func WithNull(null string) Option {
+	return func(o *exportOptions) {
+		o.null = null
+	}
+}
+
+func newOptions(opts []Option) exportOptions {
+	o := exportOptions{
+		comma:  ',',
+		header: true,
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&o)
+		}
+	}
+	return o
+}
+
+type column struct {
+	name string
+	t    types.Type
+}
+
+// rowWriter receives rows of result sets
+type rowWriter interface {
+	// columns called on each result set before its rows
+	columns(columns []column) error
+
+	// row called on each row with values in YDB representation
+	row(values []*Ydb.TypedValue) error
+
+	// flush called after all rows of result set
+	flush() error
+}
+
+// export reads all result sets of result and passes its rows into w
+func export(ctx context.Context, res result.BaseResult, w rowWriter) (err error) {
+	var (
+		values []types.Value
+		dst    []indexed.RequiredOrOptional
+		row    []*Ydb.TypedValue
+	)
+	for res.NextResultSet(ctx) {
+		var columns []column
+		res.CurrentResultSet().Columns(func(c options.Column) {
+			columns = append(columns, column{
+				name: c.Name,
+				t:    c.Type,
+			})
+		})
+		if err = w.columns(columns); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		if len(values) != len(columns) {
+			values = make([]types.Value, len(columns))
+			dst = make([]indexed.RequiredOrOptional, len(columns))
+			row = make([]*Ydb.TypedValue, len(columns))
+			for i := range values {
+				dst[i] = &values[i]
+			}
+		}
+		for res.NextRow() {
+			if err = res.Scan(dst...); err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+			if err = writeRow(w, values, row); err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+		}
+		if err = w.flush(); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+	}
+	if err = res.Err(); err != nil && !xerrors.Is(err, io.EOF) {
+		return xerrors.WithStackTrace(err)
+	}
+	return nil
+}
+
+func writeRow(w rowWriter, values []types.Value, row []*Ydb.TypedValue) error {
+	a := allocator.New()
+	defer a.Free()
+	for i, v := range values {
+		row[i] = value.ToYDB(v, a)
+	}
+	return w.row(row)
+}
+
+func columnsEqual(lhs, rhs []column) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+	for i := range lhs {
+		if lhs[i].name != rhs[i].name || !value.TypesEqual(lhs[i].t, rhs[i].t) {
+			return false
+		}
+	}
+	return true
+}
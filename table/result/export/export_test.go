@@ -0,0 +1,173 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/scanner"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+type testColumn struct {
+	name string
+	t    types.Type
+}
+
+func resultSet(columns []testColumn, rows ...[]types.Value) *Ydb.ResultSet {
+	a := allocator.New()
+	set := &Ydb.ResultSet{}
+	for _, c := range columns {
+		set.Columns = append(set.Columns, &Ydb.Column{
+			Name: c.name,
+			Type: value.TypeToYDB(c.t, a),
+		})
+	}
+	for _, row := range rows {
+		items := make([]*Ydb.Value, len(row))
+		for i, v := range row {
+			items[i] = value.ToYDB(v, a).Value
+		}
+		set.Rows = append(set.Rows, &Ydb.Value{Items: items})
+	}
+	return set
+}
+
+func testResult(sets ...*Ydb.ResultSet) result.Result {
+	return scanner.NewUnary(sets, nil)
+}
+
+var (
+	testColumns = []testColumn{
+		{"id", types.TypeUint64},
+		{"name", types.Optional(types.TypeUTF8)},
+		{"amount", types.DecimalType(22, 9)},
+		{"ts", types.TypeTimestamp},
+		{"tags", types.List(types.TypeUTF8)},
+	}
+	testTime = time.Date(2022, 8, 1, 10, 20, 30, 500000000, time.UTC)
+)
+
+func testRows() [][]types.Value {
+	return [][]types.Value{
+		{
+			types.Uint64Value(1),
+			types.OptionalValue(types.UTF8Value(`say "hi", bye`)),
+			types.DecimalValueFromBigInt(big.NewInt(123450000000), 22, 9),
+			types.TimestampValueFromTime(testTime),
+			types.ListValue(types.UTF8Value("a"), types.UTF8Value("b")),
+		},
+		{
+			types.Uint64Value(2),
+			types.NullValue(types.TypeUTF8),
+			types.DecimalValueFromBigInt(big.NewInt(-1), 22, 9),
+			types.TimestampValueFromTime(testTime.Add(time.Hour)),
+			types.ListValue(types.UTF8Value("c")),
+		},
+	}
+}
+
+func TestCSV(t *testing.T) {
+	rows := testRows()
+	var buf bytes.Buffer
+	err := CSV(context.Background(), &buf, testResult(
+		resultSet(testColumns, rows[0]),
+		resultSet(testColumns, rows[1]),
+		resultSet([]testColumn{{"count", types.TypeInt32}}, []types.Value{types.Int32Value(-5)}),
+	), WithNull("NULL"))
+	require.NoError(t, err)
+	require.Equal(t, ""+
+		"id,name,amount,ts,tags\n"+
+		`1,"say ""hi"", bye",123.450000000,2022-08-01T10:20:30.5Z,"[""a"",""b""]"`+"\n"+
+		`2,NULL,-0.000000001,2022-08-01T11:20:30.5Z,"[""c""]"`+"\n"+
+		"\n"+
+		"count\n"+
+		"-5\n",
+		buf.String(),
+	)
+
+	buf.Reset()
+	err = CSV(context.Background(), &buf, testResult(
+		resultSet([]testColumn{{"a", types.TypeInt32}, {"b", types.TypeBool}}, []types.Value{
+			types.Int32Value(1), types.BoolValue(true),
+		}),
+	), WithoutHeader(), WithComma(';'))
+	require.NoError(t, err)
+	require.Equal(t, "1;true\n", buf.String())
+}
+
+func TestJSONLines(t *testing.T) {
+	var buf bytes.Buffer
+	err := JSONLines(context.Background(), &buf, testResult(resultSet(testColumns, testRows()...)))
+	require.NoError(t, err)
+	require.Equal(t, ""+
+		`{"id":1,"name":"say \"hi\", bye","amount":"123.450000000","ts":"2022-08-01T10:20:30.5Z","tags":["a","b"]}`+"\n"+
+		`{"id":2,"name":null,"amount":"-0.000000001","ts":"2022-08-01T11:20:30.5Z","tags":["c"]}`+"\n",
+		buf.String(),
+	)
+}
+
+func TestJSON(t *testing.T) {
+	for _, tt := range []struct {
+		v   types.Value
+		exp string
+	}{
+		{types.BoolValue(true), `true`},
+		{types.Int8Value(-8), `-8`},
+		{types.DoubleValue(1.5), `1.5`},
+		{types.FloatValue(float32(0.25)), `0.25`},
+		{types.DateValueFromTime(testTime), `"2022-08-01"`},
+		{types.DatetimeValueFromTime(testTime), `"2022-08-01T10:20:30Z"`},
+		{types.IntervalValueFromDuration(90 * time.Second), `"1m30s"`},
+		{types.StringValue([]byte("text")), `"text"`},
+		{types.StringValue([]byte{0xff, 0x00}), `"/wA="`},
+		{types.JSONValue(`{"a":[1,2]}`), `{"a":[1,2]}`},
+		{types.UTF8Value("line\nbreak\x01"), `"line\nbreak\u0001"`},
+		{
+			types.UUIDValue([16]byte{0x12, 0x34, 0x56, 0x78, 0x9a, 0xbc, 0xde, 0xf0, 0, 1, 2, 3, 4, 5, 6, 7}),
+			`"04050607-0203-0001-f0de-bc9a78563412"`,
+		},
+		{types.OptionalValue(types.OptionalValue(types.Int32Value(1))), `1`},
+		{types.OptionalValue(types.NullValue(types.TypeInt32)), `null`},
+		{
+			types.StructValue(
+				types.StructFieldValue("a", types.Int32Value(1)),
+				types.StructFieldValue("b", types.TupleValue(types.UTF8Value("x"), types.NullValue(types.TypeBool))),
+			),
+			`{"a":1,"b":["x",null]}`,
+		},
+		{
+			types.DictValue(types.DictFieldValue(types.UTF8Value("k"), types.Int32Value(1))),
+			`{"k":1}`,
+		},
+		{
+			types.DictValue(types.DictFieldValue(types.Int32Value(1), types.UTF8Value("v"))),
+			`[[1,"v"]]`,
+		},
+		{
+			types.VariantValue(types.Int32Value(1), 1, types.Variant(types.Struct(
+				types.StructField("a", types.TypeUTF8),
+				types.StructField("b", types.TypeInt32),
+			))),
+			`{"b":1}`,
+		},
+		{types.ZeroValue(types.List(types.TypeInt32)), `[]`},
+	} {
+		t.Run(tt.exp, func(t *testing.T) {
+			a := allocator.New()
+			defer a.Free()
+			v := value.ToYDB(tt.v, a)
+			act, err := appendJSON(nil, v.Type, v.Value)
+			require.NoError(t, err)
+			require.Equal(t, tt.exp, string(act))
+		})
+	}
+}
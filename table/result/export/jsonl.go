@@ -0,0 +1,56 @@
+package export
+
+import (
+	"context"
+	"io"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result"
+)
+
+// JSONLines writes rows of all result sets of res into w as JSON objects (one object per line)
+// with column names as keys.
+// String values are written as JSON strings if they are valid UTF-8 and as base64 strings otherwise.
+// Json and JsonDocument values are embedded as is.
+func JSONLines(ctx context.Context, w io.Writer, res result.BaseResult) error {
+	return xerrors.WithStackTrace(export(ctx, res, &jsonLinesWriter{
+		w: w,
+	}))
+}
+
+type jsonLinesWriter struct {
+	w io.Writer
+
+	keys [][]byte
+	buf  []byte
+}
+
+func (w *jsonLinesWriter) columns(columns []column) error {
+	w.keys = make([][]byte, len(columns))
+	for i, c := range columns {
+		w.keys[i] = append(appendJSONString(nil, c.name), ':')
+	}
+	return nil
+}
+
+func (w *jsonLinesWriter) row(values []*Ydb.TypedValue) (err error) {
+	w.buf = append(w.buf[:0], '{')
+	for i, v := range values {
+		if i > 0 {
+			w.buf = append(w.buf, ',')
+		}
+		w.buf = append(w.buf, w.keys[i]...)
+		if w.buf, err = appendJSON(w.buf, v.Type, v.Value); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+	}
+	w.buf = append(w.buf, '}', '\n')
+	_, err = w.w.Write(w.buf)
+	return xerrors.WithStackTrace(err)
+}
+
+func (w *jsonLinesWriter) flush() error {
+	return nil
+}
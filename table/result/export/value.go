@@ -0,0 +1,368 @@
+package export
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/decimal"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/timeutil"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+const (
+	dateLayout = "2006-01-02"
+	hexDigits  = "0123456789abcdef"
+)
+
+// unwrap removes optional and tagged wrappers from value.
+// Returns nil type if value is NULL on any level of optionals
+func unwrap(t *Ydb.Type, v *Ydb.Value) (*Ydb.Type, *Ydb.Value) {
+	for {
+		switch tt := t.Type.(type) {
+		case *Ydb.Type_OptionalType:
+			if _, null := v.Value.(*Ydb.Value_NullFlagValue); null {
+				return nil, nil
+			}
+			t = tt.OptionalType.Item
+			if nested, ok := v.Value.(*Ydb.Value_NestedValue); ok {
+				if _, inner := value.UntagYDB(t).Type.(*Ydb.Type_OptionalType); inner {
+					v = nested.NestedValue
+				}
+			}
+		case *Ydb.Type_TaggedType:
+			t = tt.TaggedType.Type
+		case *Ydb.Type_VoidType, *Ydb.Type_NullType:
+			return nil, nil
+		default:
+			return t, v
+		}
+	}
+}
+
+// unwrapType removes optional and tagged wrappers from type
+func unwrapType(t *Ydb.Type) *Ydb.Type {
+	for {
+		switch tt := t.Type.(type) {
+		case *Ydb.Type_OptionalType:
+			t = tt.OptionalType.Item
+		case *Ydb.Type_TaggedType:
+			t = tt.TaggedType.Type
+		default:
+			return t
+		}
+	}
+}
+
+// appendText appends text representation of primitive value or JSON representation of container value.
+// Returns false if value is NULL
+func appendText(b []byte, t *Ydb.Type, v *Ydb.Value) (_ []byte, ok bool, err error) {
+	t, v = unwrap(t, v)
+	if t == nil {
+		return b, false, nil
+	}
+	if _, ok := value.PgTypeFromYDB(t); ok {
+		return append(b, v.GetTextValue()...), true, nil
+	}
+	switch tt := t.Type.(type) {
+	case *Ydb.Type_TypeId:
+		return appendPrimitiveText(b, tt.TypeId, v)
+	case *Ydb.Type_DecimalType:
+		return appendDecimal(b, tt.DecimalType, v), true, nil
+	default:
+		b, err = appendJSON(b, t, v)
+		return b, true, err
+	}
+}
+
+func appendPrimitiveText(b []byte, id Ydb.Type_PrimitiveTypeId, v *Ydb.Value) (_ []byte, ok bool, err error) {
+	switch id {
+	case Ydb.Type_BOOL:
+		return strconv.AppendBool(b, v.GetBoolValue()), true, nil
+	case Ydb.Type_INT8, Ydb.Type_INT16, Ydb.Type_INT32:
+		return strconv.AppendInt(b, int64(v.GetInt32Value()), 10), true, nil
+	case Ydb.Type_UINT8, Ydb.Type_UINT16, Ydb.Type_UINT32:
+		return strconv.AppendUint(b, uint64(v.GetUint32Value()), 10), true, nil
+	case Ydb.Type_INT64:
+		return strconv.AppendInt(b, v.GetInt64Value(), 10), true, nil
+	case Ydb.Type_UINT64:
+		return strconv.AppendUint(b, v.GetUint64Value(), 10), true, nil
+	case Ydb.Type_FLOAT:
+		return strconv.AppendFloat(b, float64(v.GetFloatValue()), 'g', -1, 32), true, nil
+	case Ydb.Type_DOUBLE:
+		return strconv.AppendFloat(b, v.GetDoubleValue(), 'g', -1, 64), true, nil
+	case Ydb.Type_DATE:
+		return timeutil.UnmarshalDate(v.GetUint32Value()).UTC().AppendFormat(b, dateLayout), true, nil
+	case Ydb.Type_DATETIME:
+		return timeutil.UnmarshalDatetime(v.GetUint32Value()).UTC().AppendFormat(b, time.RFC3339), true, nil
+	case Ydb.Type_TIMESTAMP:
+		return timeutil.UnmarshalTimestamp(v.GetUint64Value()).UTC().AppendFormat(b, time.RFC3339Nano), true, nil
+	case Ydb.Type_INTERVAL, value.TypeIDInterval64:
+		return append(b, timeutil.MicrosecondsToDuration(v.GetInt64Value()).String()...), true, nil
+	case value.TypeIDDate32:
+		return timeutil.UnmarshalDate32(v.GetInt32Value()).UTC().AppendFormat(b, dateLayout), true, nil
+	case value.TypeIDDatetime64:
+		return timeutil.UnmarshalDatetime64(v.GetInt64Value()).UTC().AppendFormat(b, time.RFC3339), true, nil
+	case value.TypeIDTimestamp64:
+		return timeutil.UnmarshalTimestamp64(v.GetInt64Value()).UTC().AppendFormat(b, time.RFC3339Nano), true, nil
+	case Ydb.Type_UUID:
+		return value.AppendUUID(b, v.GetLow_128(), v.GetHigh_128()), true, nil
+	case Ydb.Type_STRING, Ydb.Type_YSON:
+		return append(b, v.GetBytesValue()...), true, nil
+	case
+		Ydb.Type_UTF8,
+		Ydb.Type_JSON,
+		Ydb.Type_JSON_DOCUMENT,
+		Ydb.Type_DYNUMBER,
+		Ydb.Type_TZ_DATE,
+		Ydb.Type_TZ_DATETIME,
+		Ydb.Type_TZ_TIMESTAMP:
+		return append(b, v.GetTextValue()...), true, nil
+	default:
+		return b, false, xerrors.WithStackTrace(fmt.Errorf("unsupported primitive type: %v", id))
+	}
+}
+
+func appendDecimal(b []byte, t *Ydb.DecimalType, v *Ydb.Value) []byte {
+	x := decimal.FromInt128(value.BigEndianUint128(v.GetHigh_128(), v.GetLow_128()), t.Precision, t.Scale)
+	return append(b, decimal.Format(x, t.Precision, t.Scale)...)
+}
+
+// appendJSON appends JSON representation of value
+//
+//nolint:gocyclo
+func appendJSON(b []byte, t *Ydb.Type, v *Ydb.Value) (_ []byte, err error) {
+	t, v = unwrap(t, v)
+	if t == nil {
+		return append(b, "null"...), nil
+	}
+	if _, ok := value.PgTypeFromYDB(t); ok {
+		return appendJSONString(b, v.GetTextValue()), nil
+	}
+	switch tt := t.Type.(type) {
+	case *Ydb.Type_TypeId:
+		return appendPrimitiveJSON(b, tt.TypeId, v)
+
+	case *Ydb.Type_DecimalType:
+		b = append(b, '"')
+		b = appendDecimal(b, tt.DecimalType, v)
+		return append(b, '"'), nil
+
+	case *Ydb.Type_ListType:
+		b = append(b, '[')
+		for i, item := range v.Items {
+			if i > 0 {
+				b = append(b, ',')
+			}
+			if b, err = appendJSON(b, tt.ListType.Item, item); err != nil {
+				return b, err
+			}
+		}
+		return append(b, ']'), nil
+
+	case *Ydb.Type_TupleType:
+		b = append(b, '[')
+		for i, item := range v.Items {
+			if i > 0 {
+				b = append(b, ',')
+			}
+			if i >= len(tt.TupleType.Elements) {
+				return b, xerrors.WithStackTrace(fmt.Errorf("tuple item %d out of type bounds", i))
+			}
+			if b, err = appendJSON(b, tt.TupleType.Elements[i], item); err != nil {
+				return b, err
+			}
+		}
+		return append(b, ']'), nil
+
+	case *Ydb.Type_StructType:
+		b = append(b, '{')
+		for i, item := range v.Items {
+			if i > 0 {
+				b = append(b, ',')
+			}
+			if i >= len(tt.StructType.Members) {
+				return b, xerrors.WithStackTrace(fmt.Errorf("struct item %d out of type bounds", i))
+			}
+			b = appendJSONString(b, tt.StructType.Members[i].Name)
+			b = append(b, ':')
+			if b, err = appendJSON(b, tt.StructType.Members[i].Type, item); err != nil {
+				return b, err
+			}
+		}
+		return append(b, '}'), nil
+
+	case *Ydb.Type_DictType:
+		return appendDictJSON(b, tt.DictType, v)
+
+	case *Ydb.Type_VariantType:
+		nested, ok := v.Value.(*Ydb.Value_NestedValue)
+		if !ok {
+			return b, xerrors.WithStackTrace(fmt.Errorf("variant value without nested value"))
+		}
+		var (
+			i    = int(v.VariantIndex)
+			name string
+			item *Ydb.Type
+		)
+		switch items := tt.VariantType.Type.(type) {
+		case *Ydb.VariantType_TupleItems:
+			if i < len(items.TupleItems.Elements) {
+				name, item = strconv.Itoa(i), items.TupleItems.Elements[i]
+			}
+		case *Ydb.VariantType_StructItems:
+			if i < len(items.StructItems.Members) {
+				name, item = items.StructItems.Members[i].Name, items.StructItems.Members[i].Type
+			}
+		}
+		if item == nil {
+			return b, xerrors.WithStackTrace(fmt.Errorf("variant index %d out of type bounds", i))
+		}
+		b = append(b, '{')
+		b = appendJSONString(b, name)
+		b = append(b, ':')
+		if b, err = appendJSON(b, item, nested.NestedValue); err != nil {
+			return b, err
+		}
+		return append(b, '}'), nil
+
+	case *Ydb.Type_EmptyListType:
+		return append(b, "[]"...), nil
+
+	case *Ydb.Type_EmptyDictType:
+		return append(b, "{}"...), nil
+
+	default:
+		return b, xerrors.WithStackTrace(fmt.Errorf("unsupported type: %T", tt))
+	}
+}
+
+// appendDictJSON appends dict as JSON object if keys are strings or as array of key-value pairs otherwise
+func appendDictJSON(b []byte, t *Ydb.DictType, v *Ydb.Value) (_ []byte, err error) {
+	var stringKeys bool
+	if id, ok := t.Key.Type.(*Ydb.Type_TypeId); ok {
+		stringKeys = id.TypeId == Ydb.Type_UTF8 || id.TypeId == Ydb.Type_STRING
+	}
+	if stringKeys {
+		b = append(b, '{')
+	} else {
+		b = append(b, '[')
+	}
+	for i, pair := range v.Pairs {
+		if i > 0 {
+			b = append(b, ',')
+		}
+		if stringKeys {
+			if b, err = appendJSON(b, t.Key, pair.Key); err != nil {
+				return b, err
+			}
+			b = append(b, ':')
+			if b, err = appendJSON(b, t.Payload, pair.Payload); err != nil {
+				return b, err
+			}
+			continue
+		}
+		b = append(b, '[')
+		if b, err = appendJSON(b, t.Key, pair.Key); err != nil {
+			return b, err
+		}
+		b = append(b, ',')
+		if b, err = appendJSON(b, t.Payload, pair.Payload); err != nil {
+			return b, err
+		}
+		b = append(b, ']')
+	}
+	if stringKeys {
+		return append(b, '}'), nil
+	}
+	return append(b, ']'), nil
+}
+
+func appendPrimitiveJSON(b []byte, id Ydb.Type_PrimitiveTypeId, v *Ydb.Value) (_ []byte, err error) {
+	switch id {
+	case
+		Ydb.Type_BOOL,
+		Ydb.Type_INT8, Ydb.Type_INT16, Ydb.Type_INT32, Ydb.Type_INT64,
+		Ydb.Type_UINT8, Ydb.Type_UINT16, Ydb.Type_UINT32, Ydb.Type_UINT64:
+		b, _, err = appendPrimitiveText(b, id, v)
+		return b, err
+	case Ydb.Type_FLOAT:
+		return appendJSONFloat(b, float64(v.GetFloatValue()), 32), nil
+	case Ydb.Type_DOUBLE:
+		return appendJSONFloat(b, v.GetDoubleValue(), 64), nil
+	case Ydb.Type_STRING:
+		if bts := v.GetBytesValue(); !utf8.Valid(bts) {
+			b = append(b, '"')
+			b = append(b, base64.StdEncoding.EncodeToString(bts)...)
+			return append(b, '"'), nil
+		}
+		return appendJSONString(b, string(v.GetBytesValue())), nil
+	case Ydb.Type_JSON, Ydb.Type_JSON_DOCUMENT:
+		if text := v.GetTextValue(); json.Valid([]byte(text)) {
+			return append(b, text...), nil
+		}
+		return appendJSONString(b, v.GetTextValue()), nil
+	default:
+		text, _, err := appendPrimitiveText(nil, id, v)
+		if err != nil {
+			return b, err
+		}
+		return appendJSONString(b, string(text)), nil
+	}
+}
+
+// appendJSONFloat appends float as JSON number. Not finite values appends as strings
+func appendJSONFloat(b []byte, f float64, bitSize int) []byte {
+	switch {
+	case math.IsNaN(f):
+		return append(b, `"NaN"`...)
+	case math.IsInf(f, 1):
+		return append(b, `"+Inf"`...)
+	case math.IsInf(f, -1):
+		return append(b, `"-Inf"`...)
+	default:
+		return strconv.AppendFloat(b, f, 'g', -1, bitSize)
+	}
+}
+
+func appendJSONString(b []byte, s string) []byte {
+	b = append(b, '"')
+	for i := 0; i < len(s); {
+		c := s[i]
+		if c >= utf8.RuneSelf {
+			r, size := utf8.DecodeRuneInString(s[i:])
+			if r == utf8.RuneError && size == 1 {
+				b = append(b, "\ufffd"...)
+			} else {
+				b = append(b, s[i:i+size]...)
+			}
+			i += size
+			continue
+		}
+		switch c {
+		case '"', '\\':
+			b = append(b, '\\', c)
+		case '\n':
+			b = append(b, '\\', 'n')
+		case '\r':
+			b = append(b, '\\', 'r')
+		case '\t':
+			b = append(b, '\\', 't')
+		default:
+			if c < 0x20 {
+				b = append(b, '\\', 'u', '0', '0', hexDigits[c>>4], hexDigits[c&0x0f])
+			} else {
+				b = append(b, c)
+			}
+		}
+		i++
+	}
+	return append(b, '"')
+}
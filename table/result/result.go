@@ -94,6 +94,9 @@ type BaseResult interface {
 	//   ydb.Value
 	// For custom types implement sql.Scanner or json.Unmarshaler interface.
 	// For optional types use double pointer construction.
+	// Nested optional types (such as Optional<Optional<T>>) scans into double pointer as NULL
+	// if value is NULL on any level. Use types.Maybe for distinguishing of NULL and Just(NULL).
+	// Tagged types scans as their inner types.
 	// For unknown types use interface types.
 	// Supported scanning byte arrays of various length.
 	// For complex yql types: Dict, List, Tuple and own specific scanning logic
@@ -3,6 +3,7 @@ package table
 import (
 	"bytes"
 	"context"
+	"time"
 
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
@@ -11,6 +12,7 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/closer"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/result"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
@@ -67,6 +69,57 @@ type Client interface {
 	DoTx(ctx context.Context, op TxOperation, opts ...Option) error
 }
 
+// Warmer is an optional interface of Client which prepares session pool for use
+//
+// Client returned from ydb.Connection.Table() implements Warmer:
+//
+//	if w, ok := db.Table().(table.Warmer); ok {
+//		err = w.Warmup(ctx)
+//	}
+type Warmer interface {
+	// Warmup creates idle sessions up to minimum idle size of session pool (see ydb.WithSessionPoolMinIdleSize)
+	//
+	// Warmup blocks until pool is ready or context is done. Useful for readiness probes.
+	// Pool which reaches its size limit while warming up is ready
+	Warmup(ctx context.Context) error
+}
+
+// StatsProvider is an optional interface of Client which provides snapshot of session pool state
+//
+// Client returned from ydb.Connection.Table() implements StatsProvider:
+//
+//	if p, ok := db.Table().(table.StatsProvider); ok {
+//		stats := p.Stats()
+//	}
+type StatsProvider interface {
+	// Stats returns snapshot of session pool state
+	Stats() Stats
+}
+
+// Stats is a snapshot of session pool state
+type Stats struct {
+	// Limit is an upper bound of sessions count
+	Limit int
+
+	// Idle is a count of sessions which ready for use
+	Idle int
+
+	// InUse is a count of sessions which taken from pool
+	InUse int
+
+	// Waiters is a count of callers which wait for session
+	Waiters int
+
+	// CreateInProgress is a count of sessions which creating now
+	CreateInProgress int
+
+	// SessionsPerNode is a count of sessions (idle and in use) on each YDB node
+	SessionsPerNode map[uint32]int
+
+	// AverageWait is an average latency of getting session from pool
+	AverageWait time.Duration
+}
+
 type SessionInfo interface {
 	ID() string
 }
@@ -254,6 +307,9 @@ var (
 	staleReadOnly = &Ydb_Table.TransactionSettings_StaleReadOnly{
 		StaleReadOnly: &Ydb_Table.StaleModeSettings{},
 	}
+	snapshotReadOnly = &Ydb_Table.TransactionSettings_SnapshotReadOnly{
+		SnapshotReadOnly: &Ydb_Table.SnapshotModeSettings{},
+	}
 )
 
 // Transaction control options
@@ -308,6 +364,14 @@ func WithStaleReadOnly() TxOption {
 	}
 }
 
+// WithSnapshotReadOnly defines read-only transaction which reads consistent snapshot of data.
+// Server which not supports snapshot read-only transactions responds with BAD_REQUEST
+func WithSnapshotReadOnly() TxOption {
+	return func(d *txDesc) {
+		d.TxMode = snapshotReadOnly
+	}
+}
+
 func WithOnlineReadOnly(opts ...TxOnlineReadOnlyOption) TxOption {
 	return func(d *txDesc) {
 		var ro txOnlineReadOnly
@@ -381,6 +445,14 @@ func OnlineReadOnlyTxControl(opts ...TxOnlineReadOnlyOption) *TransactionControl
 	)
 }
 
+// SnapshotReadOnlyTxControl returns snapshot read-only transaction control
+func SnapshotReadOnlyTxControl() *TransactionControl {
+	return TxControl(
+		BeginTx(WithSnapshotReadOnly()),
+		CommitTx(),
+	)
+}
+
 // StaleReadOnlyTxControl returns stale read-only transaction control
 func StaleReadOnlyTxControl() *TransactionControl {
 	return TxControl(
@@ -472,6 +544,7 @@ type Options struct {
 	TxCommitOptions []options.CommitTransactionOption
 	FastBackoff     backoff.Backoff
 	SlowBackoff     backoff.Backoff
+	RetryPolicy     retry.Policy
 	Trace           trace.Table
 }
 
@@ -495,6 +568,32 @@ func WithTxCommitOptions(opts ...options.CommitTransactionOption) Option {
 	}
 }
 
+// WithRetryPolicy replaces retry policy of table client for call.
+// Backoffs from WithFastBackoff and WithSlowBackoff options of call are not used with this policy
+func WithRetryPolicy(p retry.Policy) Option {
+	return func(o *Options) {
+		o.RetryPolicy = p
+	}
+}
+
+// WithFastBackoff replaces fast backoff of call.
+// It overrides fast backoff of table client retry policy (see ydb.WithRetryPolicy),
+// but not used with retry policy of call (see WithRetryPolicy)
+func WithFastBackoff(b retry.BackoffStrategy) Option {
+	return func(o *Options) {
+		o.FastBackoff = b
+	}
+}
+
+// WithSlowBackoff replaces slow backoff of call.
+// It overrides slow backoff of table client retry policy (see ydb.WithRetryPolicy),
+// but not used with retry policy of call (see WithRetryPolicy)
+func WithSlowBackoff(b retry.BackoffStrategy) Option {
+	return func(o *Options) {
+		o.SlowBackoff = b
+	}
+}
+
 func WithTrace(t trace.Table) Option {
 	return func(o *Options) {
 		o.Trace = o.Trace.Compose(t)
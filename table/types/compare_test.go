@@ -10,8 +10,11 @@ import (
 // Compare compares its operands.
 // It returns -1, 0, 1 if l < r, l == r, l > r. Returns error if types are not comparable.
 // Comparable types are all integer types, UUID, DyNumber, Float, Double, String, UTF8,
-// Date, Datetime, Timestamp, Tuples and Lists.
+// Date, Datetime, Timestamp, Date32, Datetime64, Timestamp64, Interval64, PostgreSQL-compatible values,
+// Tuples and Lists.
 // Primitive arguments are comparable if their types are the same.
+// PostgreSQL-compatible values are comparable if their OIDs are the same. Values of numeric PostgreSQL types
+// compares as numbers, other values compares by text representation.
 // Optional types is comparable to underlying types, e.g. Optional<Optional<Float>> is comparable to Float.
 // Null value is comparable to non-null value of the same types and is considered less than any non-null value.
 // Tuples and Lists are comparable if their elements are comparable.
@@ -276,6 +279,63 @@ func TestDyNumber(t *testing.T) {
 	requireEqualValues(t, 0, c)
 }
 
+func TestDate32(t *testing.T) {
+	l := Date32Value(-10)
+	r := Date32Value(1)
+	c, err := Compare(l, r)
+	requireNoError(t, err)
+	requireEqualValues(t, -1, c)
+
+	c, err = Compare(r, l)
+	requireNoError(t, err)
+	requireEqualValues(t, 1, c)
+
+	c, err = Compare(l, l)
+	requireNoError(t, err)
+	requireEqualValues(t, 0, c)
+}
+
+func TestTimestamp64(t *testing.T) {
+	l := Timestamp64Value(-10)
+	r := Timestamp64Value(1)
+	c, err := Compare(l, r)
+	requireNoError(t, err)
+	requireEqualValues(t, -1, c)
+
+	c, err = Compare(r, l)
+	requireNoError(t, err)
+	requireEqualValues(t, 1, c)
+
+	c, err = Compare(l, l)
+	requireNoError(t, err)
+	requireEqualValues(t, 0, c)
+}
+
+func TestPg(t *testing.T) {
+	l := PgValue(PgInt4OID, "2")
+	r := PgValue(PgInt4OID, "12")
+	c, err := Compare(l, r)
+	requireNoError(t, err)
+	requireEqualValues(t, -1, c)
+
+	c, err = Compare(r, l)
+	requireNoError(t, err)
+	requireEqualValues(t, 1, c)
+
+	c, err = Compare(NullValue(Pg(PgInt4OID)), l)
+	requireNoError(t, err)
+	requireEqualValues(t, -1, c)
+
+	c, err = Compare(PgValue(PgTextOID, "2"), PgValue(PgTextOID, "12"))
+	requireNoError(t, err)
+	requireEqualValues(t, 1, c)
+
+	_, err = Compare(l, PgValue(PgInt8OID, "2"))
+	if !xerrors.Is(err, testutil.ErrNotComparable) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
 func TestUUID(t *testing.T) {
 	l := UUIDValue([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
 	r := UUIDValue([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 17})
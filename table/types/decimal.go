@@ -0,0 +1,360 @@
+package types
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/decimal"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// DecimalMaxPrecision is a maximum precision of YDB Decimal type
+const DecimalMaxPrecision = decimal.MaxPrecision
+
+var (
+	// ErrDecimalOverflow returns when result of decimal operation not fits into precision
+	ErrDecimalOverflow = errors.New("decimal overflow")
+
+	// ErrDecimalDivisionByZero returns when decimal divides by zero
+	ErrDecimalDivisionByZero = errors.New("decimal division by zero")
+
+	// ErrDecimalNotFinite returns on arithmetic operations with inf and nan decimal values
+	ErrDecimalNotFinite = errors.New("decimal value is not finite")
+
+	// ErrDecimalPrecision returns when precision or scale of decimal is wrong
+	ErrDecimalPrecision = errors.New("wrong decimal precision or scale")
+)
+
+var _ sql.Scanner = (*Decimal)(nil)
+
+// Decimal supported in scanner API
+//
+// Decimal is a value of YDB Decimal(Precision,Scale) type. Bytes holds unscaled value as big-endian int128.
+// Decimal scans from YDB Decimal values with table scanner and database/sql and binds as query parameter
+// both with DecimalValue and directly as database/sql argument
+type Decimal struct {
+	Bytes     [16]byte
+	Precision uint32
+	Scale     uint32
+}
+
+// RoundingMode defines rounding of decimal values which not fits into scale
+type RoundingMode int
+
+const (
+	// RoundHalfEven rounds to nearest value, ties to even digit (banker's rounding).
+	// RoundHalfEven is a default rounding mode and used by YDB on decimal conversions
+	RoundHalfEven = RoundingMode(iota)
+
+	// RoundHalfUp rounds to nearest value, ties away from zero
+	RoundHalfUp
+
+	// RoundHalfDown rounds to nearest value, ties toward zero
+	RoundHalfDown
+
+	// RoundUp rounds away from zero
+	RoundUp
+
+	// RoundDown rounds toward zero (truncates)
+	RoundDown
+
+	// RoundCeiling rounds toward positive infinity
+	RoundCeiling
+
+	// RoundFloor rounds toward negative infinity
+	RoundFloor
+)
+
+func pow10(n uint32) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+func checkDecimalPrecision(precision, scale uint32) error {
+	if precision == 0 || precision > DecimalMaxPrecision || scale > precision {
+		return xerrors.WithStackTrace(fmt.Errorf("%w: Decimal(%d,%d)", ErrDecimalPrecision, precision, scale))
+	}
+	return nil
+}
+
+// round rounds quotient q with remainder rem of division by positive den
+func round(q, rem, den *big.Int, mode RoundingMode) *big.Int {
+	if rem.Sign() == 0 {
+		return q
+	}
+	var (
+		sign = rem.Sign()
+		half = new(big.Int).Abs(rem)
+		away bool
+	)
+	half = half.Lsh(half, 1)
+	switch cmp := half.Cmp(den); mode {
+	case RoundHalfUp:
+		away = cmp >= 0
+	case RoundHalfDown:
+		away = cmp > 0
+	case RoundUp:
+		away = true
+	case RoundDown:
+		away = false
+	case RoundCeiling:
+		away = sign > 0
+	case RoundFloor:
+		away = sign < 0
+	default:
+		away = cmp > 0 || (cmp == 0 && q.Bit(0) == 1)
+	}
+	if away {
+		q.Add(q, big.NewInt(int64(sign)))
+	}
+	return q
+}
+
+// DecimalFromBigInt makes decimal from unscaled value v
+func DecimalFromBigInt(v *big.Int, precision, scale uint32) (Decimal, error) {
+	if err := checkDecimalPrecision(precision, scale); err != nil {
+		return Decimal{}, err
+	}
+	if v.CmpAbs(pow10(precision)) >= 0 {
+		return Decimal{}, xerrors.WithStackTrace(fmt.Errorf("%w: %v with precision %d", ErrDecimalOverflow, v, precision))
+	}
+	return Decimal{
+		Bytes:     decimal.BigIntToByte(v, precision, scale),
+		Precision: precision,
+		Scale:     scale,
+	}, nil
+}
+
+// DecimalFromRat makes decimal from rational number r rounded into scale with given rounding mode
+func DecimalFromRat(r *big.Rat, precision, scale uint32, mode RoundingMode) (Decimal, error) {
+	if err := checkDecimalPrecision(precision, scale); err != nil {
+		return Decimal{}, err
+	}
+	num := new(big.Int).Mul(r.Num(), pow10(scale))
+	q, rem := new(big.Int).QuoRem(num, r.Denom(), new(big.Int))
+	return DecimalFromBigInt(round(q, rem, r.Denom(), mode), precision, scale)
+}
+
+func nonFiniteDecimal(x *big.Int, precision, scale uint32) (Decimal, error) {
+	if err := checkDecimalPrecision(precision, scale); err != nil {
+		return Decimal{}, err
+	}
+	return Decimal{
+		Bytes:     decimal.BigIntToByte(x, precision, scale),
+		Precision: precision,
+		Scale:     scale,
+	}, nil
+}
+
+// ParseDecimal parses decimal from string such as "-123.45", "1e-3", "inf" or "nan".
+// Digits which not fits into scale rounds with RoundHalfEven
+func ParseDecimal(s string, precision, scale uint32) (Decimal, error) {
+	switch t := strings.ToLower(strings.TrimPrefix(s, "+")); t {
+	case "inf", "-inf", "nan", "-nan":
+		x := decimal.Inf()
+		if strings.HasSuffix(t, "nan") {
+			x = decimal.NaN()
+		}
+		if t[0] == '-' {
+			x.Neg(x)
+		}
+		return nonFiniteDecimal(x, precision, scale)
+	}
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return Decimal{}, xerrors.WithStackTrace(fmt.Errorf("decimal: parse %q: invalid syntax", s))
+	}
+	return DecimalFromRat(r, precision, scale, RoundHalfEven)
+}
+
+// DecimalFromFloat makes decimal from shortest decimal representation of float value v
+// (such as 0.1 for float64(0.1)). Digits which not fits into scale rounds with RoundHalfEven
+func DecimalFromFloat(v float64, precision, scale uint32) (Decimal, error) {
+	switch {
+	case math.IsNaN(v):
+		return nonFiniteDecimal(decimal.NaN(), precision, scale)
+	case math.IsInf(v, 0):
+		x := decimal.Inf()
+		if v < 0 {
+			x.Neg(x)
+		}
+		return nonFiniteDecimal(x, precision, scale)
+	}
+	return ParseDecimal(strconv.FormatFloat(v, 'g', -1, 64), precision, scale)
+}
+
+// String returns decimal text with exact scale digits, such as "123.450000000".
+// Infinite and not-a-number values formats as "inf", "-inf" and "nan"
+func (d Decimal) String() string {
+	v := decimal.FromInt128(d.Bytes, d.Precision, d.Scale)
+	return decimal.Format(v, d.Precision, d.Scale)
+}
+
+// BigInt returns unscaled value of decimal
+func (d Decimal) BigInt() *big.Int {
+	return decimal.FromInt128(d.Bytes, d.Precision, d.Scale)
+}
+
+// IsFinite reports whether decimal is not an infinity or not-a-number value
+func (d Decimal) IsFinite() bool {
+	x := d.BigInt()
+	return !decimal.IsInf(x) && !decimal.IsNaN(x)
+}
+
+// Rat returns exact rational value of decimal or nil for not finite decimal
+func (d Decimal) Rat() *big.Rat {
+	if !d.IsFinite() {
+		return nil
+	}
+	return new(big.Rat).SetFrac(d.BigInt(), pow10(d.Scale))
+}
+
+// Float64 returns nearest float value of decimal
+func (d Decimal) Float64() float64 {
+	x := d.BigInt()
+	switch {
+	case decimal.IsNaN(x):
+		return math.NaN()
+	case decimal.IsInf(x):
+		return math.Inf(x.Sign())
+	}
+	f, _ := new(big.Rat).SetFrac(x, pow10(d.Scale)).Float64()
+	return f
+}
+
+// Sign returns -1, 0 or +1 for negative, zero and positive decimal
+func (d Decimal) Sign() int {
+	return d.BigInt().Sign()
+}
+
+// Neg returns decimal with opposite sign
+func (d Decimal) Neg() Decimal {
+	x := d.BigInt()
+	d.Bytes = decimal.BigIntToByte(x.Neg(x), d.Precision, d.Scale)
+	return d
+}
+
+// Cmp compares decimals with any precision and scale and returns -1, 0 or +1
+// if d is less than, equal to or greater than x.
+// Negative infinity is less and positive infinity is greater than any finite value,
+// not-a-number values are greater than any other value
+func (d Decimal) Cmp(x Decimal) int {
+	lhs, rhs := d.Rat(), x.Rat()
+	if lhs != nil && rhs != nil {
+		return lhs.Cmp(rhs)
+	}
+	order := func(d Decimal, r *big.Rat) int {
+		switch x := d.BigInt(); {
+		case r != nil:
+			return 0
+		case decimal.IsNaN(x):
+			return 2
+		case x.Sign() < 0:
+			return -1
+		default:
+			return 1
+		}
+	}
+	switch l, r := order(d, lhs), order(x, rhs); {
+	case l < r:
+		return -1
+	case l > r:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Rescale converts decimal to other precision and scale. Digits which not fits into scale rounds with mode
+func (d Decimal) Rescale(precision, scale uint32, mode RoundingMode) (Decimal, error) {
+	r := d.Rat()
+	if r == nil {
+		return nonFiniteDecimal(d.BigInt(), precision, scale)
+	}
+	return DecimalFromRat(r, precision, scale, mode)
+}
+
+func (d Decimal) apply(x Decimal, mode RoundingMode, op func(lhs, rhs *big.Rat) (*big.Rat, error)) (Decimal, error) {
+	lhs, rhs := d.Rat(), x.Rat()
+	if lhs == nil || rhs == nil {
+		return Decimal{}, xerrors.WithStackTrace(ErrDecimalNotFinite)
+	}
+	r, err := op(lhs, rhs)
+	if err != nil {
+		return Decimal{}, xerrors.WithStackTrace(err)
+	}
+	return DecimalFromRat(r, d.Precision, d.Scale, mode)
+}
+
+// Add returns sum of decimals in precision and scale of d.
+// Digits of x which not fits into scale of d rounds with RoundHalfEven
+func (d Decimal) Add(x Decimal) (Decimal, error) {
+	return d.apply(x, RoundHalfEven, func(lhs, rhs *big.Rat) (*big.Rat, error) {
+		return lhs.Add(lhs, rhs), nil
+	})
+}
+
+// Sub returns difference of decimals in precision and scale of d.
+// Digits of x which not fits into scale of d rounds with RoundHalfEven
+func (d Decimal) Sub(x Decimal) (Decimal, error) {
+	return d.apply(x, RoundHalfEven, func(lhs, rhs *big.Rat) (*big.Rat, error) {
+		return lhs.Sub(lhs, rhs), nil
+	})
+}
+
+// Mul returns product of decimals in precision and scale of d rounded with mode
+func (d Decimal) Mul(x Decimal, mode RoundingMode) (Decimal, error) {
+	return d.apply(x, mode, func(lhs, rhs *big.Rat) (*big.Rat, error) {
+		return lhs.Mul(lhs, rhs), nil
+	})
+}
+
+// Div returns quotient of decimals in precision and scale of d rounded with mode
+func (d Decimal) Div(x Decimal, mode RoundingMode) (Decimal, error) {
+	return d.apply(x, mode, func(lhs, rhs *big.Rat) (*big.Rat, error) {
+		if rhs.Sign() == 0 {
+			return nil, ErrDecimalDivisionByZero
+		}
+		return lhs.Quo(lhs, rhs), nil
+	})
+}
+
+// Scan implements sql.Scanner for scanning of YDB Decimal values with database/sql.
+// Scan also accepts strings and numbers and converts them into precision and scale of d
+// (Decimal(22,9) if precision of d is not defined)
+func (d *Decimal) Scan(src interface{}) (err error) {
+	precision, scale := d.Precision, d.Scale
+	if precision == 0 {
+		precision, scale = 22, 9
+	}
+	// value parsed into temporary, so d keeps previous value on error
+	var v Decimal
+	switch src := src.(type) {
+	case Decimal:
+		v = src
+	case *Decimal:
+		if src == nil {
+			return xerrors.WithStackTrace(fmt.Errorf("cannot scan nil %T into types.Decimal", src))
+		}
+		v = *src
+	case string:
+		v, err = ParseDecimal(src, precision, scale)
+	case []byte:
+		v, err = ParseDecimal(string(src), precision, scale)
+	case int64:
+		v, err = DecimalFromRat(new(big.Rat).SetInt64(src), precision, scale, RoundHalfEven)
+	case float64:
+		v, err = DecimalFromFloat(src, precision, scale)
+	default:
+		return xerrors.WithStackTrace(fmt.Errorf("cannot scan %T into types.Decimal", src))
+	}
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	*d = v
+	return nil
+}
@@ -0,0 +1,204 @@
+package types
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+)
+
+func mustParseDecimal(t *testing.T, s string, precision, scale uint32) Decimal {
+	d, err := ParseDecimal(s, precision, scale)
+	if err != nil {
+		t.Fatalf("parse %q: %v", s, err)
+	}
+	return d
+}
+
+func TestParseDecimal(t *testing.T) {
+	for _, tt := range []struct {
+		s         string
+		precision uint32
+		scale     uint32
+		exp       string
+		err       error
+	}{
+		{s: "123.45", precision: 22, scale: 9, exp: "123.450000000"},
+		{s: "-0.5", precision: 5, scale: 0, exp: "0"},
+		{s: "1.5", precision: 5, scale: 0, exp: "2"},
+		{s: "2.5", precision: 5, scale: 0, exp: "2"},
+		{s: "1e3", precision: 5, scale: 1, exp: "1000.0"},
+		{s: "-inf", precision: 5, scale: 1, exp: "-inf"},
+		{s: "nan", precision: 5, scale: 1, exp: "nan"},
+		{s: "10000", precision: 5, scale: 1, err: ErrDecimalOverflow},
+		{s: "1", precision: 36, scale: 1, err: ErrDecimalPrecision},
+		{s: "1", precision: 5, scale: 6, err: ErrDecimalPrecision},
+		{s: "1.2.3", precision: 5, scale: 1, err: errors.New("syntax")},
+	} {
+		t.Run(tt.s, func(t *testing.T) {
+			d, err := ParseDecimal(tt.s, tt.precision, tt.scale)
+			if tt.err != nil {
+				if err == nil {
+					t.Fatalf("unexpected success: %s", d)
+				}
+				if errors.Is(tt.err, ErrDecimalOverflow) || errors.Is(tt.err, ErrDecimalPrecision) {
+					if !errors.Is(err, tt.err) {
+						t.Fatalf("unexpected error: %v", err)
+					}
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if d.String() != tt.exp {
+				t.Errorf("unexpected value: %s, want: %s", d, tt.exp)
+			}
+		})
+	}
+}
+
+func TestDecimalFromFloat(t *testing.T) {
+	d, err := DecimalFromFloat(2.675, 10, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.String() != "2.68" {
+		t.Errorf("unexpected value: %s", d)
+	}
+	if f := d.Float64(); f != 2.68 {
+		t.Errorf("unexpected float: %v", f)
+	}
+	d, err = DecimalFromFloat(math.Inf(-1), 10, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.IsFinite() || !math.IsInf(d.Float64(), -1) {
+		t.Errorf("unexpected value: %s", d)
+	}
+}
+
+func TestDecimalRounding(t *testing.T) {
+	for _, tt := range []struct {
+		mode RoundingMode
+		exp  [6]string
+	}{
+		{mode: RoundHalfEven, exp: [6]string{"2", "2", "3", "-2", "-2", "-3"}},
+		{mode: RoundHalfUp, exp: [6]string{"2", "3", "3", "-2", "-3", "-3"}},
+		{mode: RoundHalfDown, exp: [6]string{"2", "2", "3", "-2", "-2", "-3"}},
+		{mode: RoundUp, exp: [6]string{"3", "3", "3", "-3", "-3", "-3"}},
+		{mode: RoundDown, exp: [6]string{"2", "2", "2", "-2", "-2", "-2"}},
+		{mode: RoundCeiling, exp: [6]string{"3", "3", "3", "-2", "-2", "-2"}},
+		{mode: RoundFloor, exp: [6]string{"2", "2", "2", "-3", "-3", "-3"}},
+	} {
+		for i, s := range []string{"2.25", "2.5", "2.75", "-2.25", "-2.5", "-2.75"} {
+			d, err := mustParseDecimal(t, s, 10, 2).Rescale(10, 0, tt.mode)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if d.String() != tt.exp[i] {
+				t.Errorf("rescale %s with mode %d: %s, want: %s", s, tt.mode, d, tt.exp[i])
+			}
+		}
+	}
+}
+
+func TestDecimalArithmetic(t *testing.T) {
+	var (
+		a = mustParseDecimal(t, "10.50", 10, 2)
+		b = mustParseDecimal(t, "3", 5, 0)
+		c = mustParseDecimal(t, "0.005", 10, 3)
+	)
+	for _, tt := range []struct {
+		name string
+		op   func() (Decimal, error)
+		exp  string
+	}{
+		{name: "add", op: func() (Decimal, error) { return a.Add(b) }, exp: "13.50"},
+		{name: "add rounds", op: func() (Decimal, error) { return a.Add(c) }, exp: "10.50"},
+		{name: "sub", op: func() (Decimal, error) { return a.Sub(b) }, exp: "7.50"},
+		{name: "mul", op: func() (Decimal, error) { return a.Mul(b, RoundHalfEven) }, exp: "31.50"},
+		{name: "mul rounds", op: func() (Decimal, error) { return a.Mul(c, RoundUp) }, exp: "0.06"},
+		{name: "div", op: func() (Decimal, error) { return a.Div(b, RoundHalfEven) }, exp: "3.50"},
+		{name: "div rounds", op: func() (Decimal, error) { return b.Div(mustParseDecimal(t, "7", 5, 0), RoundFloor) }, exp: "0"},
+		{name: "neg", op: func() (Decimal, error) { return a.Neg(), nil }, exp: "-10.50"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := tt.op()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if d.String() != tt.exp {
+				t.Errorf("unexpected value: %s, want: %s", d, tt.exp)
+			}
+		})
+	}
+	if _, err := a.Div(mustParseDecimal(t, "0", 5, 0), RoundHalfEven); !errors.Is(err, ErrDecimalDivisionByZero) {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if _, err := mustParseDecimal(t, "99999999.99", 10, 2).Add(b); !errors.Is(err, ErrDecimalOverflow) {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if _, err := a.Add(mustParseDecimal(t, "inf", 10, 2)); !errors.Is(err, ErrDecimalNotFinite) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestDecimalCmp(t *testing.T) {
+	ordered := []Decimal{
+		mustParseDecimal(t, "-inf", 22, 9),
+		mustParseDecimal(t, "-1.5", 10, 2),
+		mustParseDecimal(t, "0", 5, 0),
+		mustParseDecimal(t, "1.49", 22, 9),
+		mustParseDecimal(t, "1.5", 3, 1),
+		mustParseDecimal(t, "inf", 22, 9),
+		mustParseDecimal(t, "nan", 22, 9),
+	}
+	for i := range ordered {
+		for j := range ordered {
+			exp := 0
+			switch {
+			case i < j:
+				exp = -1
+			case i > j:
+				exp = 1
+			}
+			if cmp := ordered[i].Cmp(ordered[j]); cmp != exp {
+				t.Errorf("%s cmp %s: %d, want: %d", ordered[i], ordered[j], cmp, exp)
+			}
+		}
+	}
+	if ordered[4].Cmp(mustParseDecimal(t, "1.500", 22, 9)) != 0 {
+		t.Errorf("equal decimals with different scales not equal")
+	}
+}
+
+func TestDecimalScan(t *testing.T) {
+	d := Decimal{Precision: 10, Scale: 2}
+	for _, src := range []interface{}{"1.5", []byte("1.5"), 1.5, mustParseDecimal(t, "1.5", 10, 2)} {
+		if err := d.Scan(src); err != nil {
+			t.Fatal(err)
+		}
+		if d.String() != "1.50" {
+			t.Errorf("unexpected value: %s", d)
+		}
+	}
+	if err := d.Scan(int64(3)); err != nil || d.String() != "3.00" {
+		t.Errorf("unexpected value: %s (%v)", d, err)
+	}
+	if err := d.Scan(nil); err == nil {
+		t.Errorf("unexpected success")
+	}
+	if err := d.Scan((*Decimal)(nil)); err == nil {
+		t.Errorf("unexpected success")
+	}
+	if err := d.Scan("not a number"); err == nil {
+		t.Errorf("unexpected success")
+	}
+	if err := d.Scan("123456789012"); err == nil {
+		t.Errorf("unexpected success")
+	}
+	if r := d.Rat(); r.Cmp(big.NewRat(3, 1)) != 0 {
+		t.Errorf("unexpected rat: %v", r)
+	}
+}
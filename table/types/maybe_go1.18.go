@@ -0,0 +1,136 @@
+//go:build go1.18
+// +build go1.18
+
+package types
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// Maybe is a destination for scanning of optional values which keeps NULL on each level of nested optionals.
+// Maybe implements Scanner, so it can be scanned with Scan and ScanNamed methods of result set:
+//
+//	var v types.Maybe[types.Maybe[int32]]
+//	err := res.ScanNamed(named.Optional("value", &v))
+//	// v.Valid is false for NULL, v.Valid is true and v.Value.Valid is false for Just(NULL)
+//
+// Value of non-optional types scans as valid value
+type Maybe[T any] struct {
+	Value T
+	Valid bool
+}
+
+// UnmarshalYDB implements Scanner
+func (o *Maybe[T]) UnmarshalYDB(raw RawValue) error {
+	*o = Maybe[T]{}
+	if raw.IsNull() {
+		return nil
+	}
+	if raw.IsOptional() {
+		raw.Unwrap()
+	}
+	o.Valid = true
+	switch dst := interface{}(&o.Value).(type) {
+	case Scanner:
+		return dst.UnmarshalYDB(raw)
+	case *Value:
+		*dst = raw.Value()
+		return nil
+	default:
+		return assign(reflect.ValueOf(dst).Elem(), raw.Any())
+	}
+}
+
+// assign sets src to dst with conversion of numeric types and conversion between strings and bytes
+func assign(dst reflect.Value, src interface{}) error {
+	if src == nil {
+		return xerrors.WithStackTrace(fmt.Errorf("cannot scan value of unsupported type into %s", dst.Type()))
+	}
+	v := reflect.ValueOf(src)
+	switch {
+	case v.Type().AssignableTo(dst.Type()):
+		dst.Set(v)
+	case isNumber(v.Kind()) && isNumber(dst.Kind()):
+		if overflows(dst, v) {
+			return xerrors.WithStackTrace(fmt.Errorf("overflow error: %v overflows capacity of %s", src, dst.Type()))
+		}
+		dst.Set(v.Convert(dst.Type()))
+	case isText(v.Type()) && isText(dst.Type()):
+		dst.Set(v.Convert(dst.Type()))
+	default:
+		return xerrors.WithStackTrace(fmt.Errorf("cannot scan %T into %s", src, dst.Type()))
+	}
+	return nil
+}
+
+// overflows reports whether numeric value v cannot be converted to type of dst without loss.
+// Floats converts to integers only if floats have no fractional part
+func overflows(dst, v reflect.Value) bool {
+	switch {
+	case isInt(v.Kind()):
+		x := v.Int()
+		switch {
+		case isInt(dst.Kind()):
+			return dst.OverflowInt(x)
+		case isUint(dst.Kind()):
+			return x < 0 || dst.OverflowUint(uint64(x))
+		}
+	case isUint(v.Kind()):
+		x := v.Uint()
+		switch {
+		case isInt(dst.Kind()):
+			return x > math.MaxInt64 || dst.OverflowInt(int64(x))
+		case isUint(dst.Kind()):
+			return dst.OverflowUint(x)
+		}
+	default:
+		x := v.Float()
+		switch {
+		case isInt(dst.Kind()):
+			return x != math.Trunc(x) || x < math.MinInt64 || x >= math.MaxInt64 || dst.OverflowInt(int64(x))
+		case isUint(dst.Kind()):
+			return x != math.Trunc(x) || x < 0 || x >= math.MaxUint64 || dst.OverflowUint(uint64(x))
+		default:
+			return !math.IsInf(x, 0) && !math.IsNaN(x) && dst.OverflowFloat(x)
+		}
+	}
+	return false
+}
+
+func isInt(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true
+	default:
+		return false
+	}
+}
+
+func isUint(k reflect.Kind) bool {
+	switch k {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	default:
+		return false
+	}
+}
+
+func isNumber(k reflect.Kind) bool {
+	switch k {
+	case
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
+func isText(t reflect.Type) bool {
+	return t.Kind() == reflect.String || t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8
+}
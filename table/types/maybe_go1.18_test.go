@@ -0,0 +1,54 @@
+//go:build go1.18
+// +build go1.18
+
+package types
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestAssign(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		dst  interface{}
+		src  interface{}
+		exp  interface{}
+		err  bool
+	}{
+		{name: "int32 into int64", dst: new(int64), src: int32(-1), exp: int64(-1)},
+		{name: "int64 into int8", dst: new(int8), src: int64(127), exp: int8(127)},
+		{name: "int64 into int8 overflow", dst: new(int8), src: int64(128), err: true},
+		{name: "negative into uint", dst: new(uint32), src: int32(-1), err: true},
+		{name: "uint64 into int64 overflow", dst: new(int64), src: uint64(math.MaxUint64), err: true},
+		{name: "uint32 into uint8 overflow", dst: new(uint8), src: uint32(256), err: true},
+		{name: "float64 into float32", dst: new(float32), src: 1.5, exp: float32(1.5)},
+		{name: "float64 into float32 overflow", dst: new(float32), src: math.MaxFloat64, err: true},
+		{name: "float64 into int", dst: new(int), src: 2.0, exp: 2},
+		{name: "fractional float64 into int", dst: new(int), src: 2.5, err: true},
+		{name: "int64 into float64", dst: new(float64), src: int64(3), exp: 3.0},
+		{name: "bytes into string", dst: new(string), src: []byte("text"), exp: "text"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			dst := reflect.ValueOf(tt.dst).Elem()
+			before := dst.Interface()
+			err := assign(dst, tt.src)
+			if tt.err {
+				if err == nil {
+					t.Fatalf("unexpected success: %v", dst.Interface())
+				}
+				if dst.Interface() != before {
+					t.Errorf("destination changed on error: %v", dst.Interface())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if dst.Interface() != tt.exp {
+				t.Errorf("unexpected value: %v, want: %v", dst.Interface(), tt.exp)
+			}
+		})
+	}
+}
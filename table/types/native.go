@@ -0,0 +1,241 @@
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/timeutil"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// ToGo converts YDB value to native Go value recursively:
+//   - Bool, integer and floating point values to bool, int8 ... uint64, float32 and float64
+//   - Date, Datetime, Timestamp, Date32, Datetime64, Timestamp64 and Tz* values to time.Time,
+//     Interval and Interval64 to time.Duration
+//   - Utf8, Yson, Json, JsonDocument and DyNumber values to string, String values to []byte
+//   - UUID to [16]byte, Decimal to Decimal, PostgreSQL-compatible values to string
+//   - Optional values to pointer to inner value, NULL and Void values to nil
+//   - Tagged values to inner value
+//   - List and Tuple values to []interface{}, Struct values to map[string]interface{}
+//   - Dict values to map[interface{}]interface{} (String keys converted to string)
+//   - Variant values to map[string]interface{} with single member name (or item index) key
+func ToGo(v Value) (interface{}, error) {
+	a := allocator.New()
+	defer a.Free()
+
+	typedValue := value.ToYDB(v, a)
+
+	x, err := toGo(typedValue.Type, typedValue.Value)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return x, nil
+}
+
+// UnmarshalJSON makes value from JSON representation of value which made with json.Marshal
+//
+//	b, err := json.Marshal(types.OptionalValue(types.Int32Value(1)))
+//	// b is `{"type":"Optional<Int32>","value":1}`
+//	v, err := types.UnmarshalJSON(b)
+//
+// Date, Datetime and Timestamp values before 1970-01-01 or since 2106-01-01 are rejected
+// with out of range error, use Date32, Datetime64 and Timestamp64 types for such values
+func UnmarshalJSON(data []byte) (Value, error) {
+	return value.UnmarshalJSON(data)
+}
+
+//nolint:gocyclo
+func primitiveToGo(id Ydb.Type_PrimitiveTypeId, v *Ydb.Value) (interface{}, error) {
+	switch id {
+	case Ydb.Type_BOOL:
+		return v.GetBoolValue(), nil
+	case Ydb.Type_INT8:
+		return int8(v.GetInt32Value()), nil
+	case Ydb.Type_INT16:
+		return int16(v.GetInt32Value()), nil
+	case Ydb.Type_INT32:
+		return v.GetInt32Value(), nil
+	case Ydb.Type_UINT8:
+		return uint8(v.GetUint32Value()), nil
+	case Ydb.Type_UINT16:
+		return uint16(v.GetUint32Value()), nil
+	case Ydb.Type_UINT32:
+		return v.GetUint32Value(), nil
+	case Ydb.Type_INT64:
+		return v.GetInt64Value(), nil
+	case Ydb.Type_UINT64:
+		return v.GetUint64Value(), nil
+	case Ydb.Type_FLOAT:
+		return v.GetFloatValue(), nil
+	case Ydb.Type_DOUBLE:
+		return v.GetDoubleValue(), nil
+	case Ydb.Type_DATE:
+		return timeutil.UnmarshalDate(v.GetUint32Value()), nil
+	case Ydb.Type_DATETIME:
+		return timeutil.UnmarshalDatetime(v.GetUint32Value()), nil
+	case Ydb.Type_TIMESTAMP:
+		return timeutil.UnmarshalTimestamp(v.GetUint64Value()), nil
+	case Ydb.Type_INTERVAL, value.TypeIDInterval64:
+		return timeutil.MicrosecondsToDuration(v.GetInt64Value()), nil
+	case value.TypeIDDate32:
+		return timeutil.UnmarshalDate32(v.GetInt32Value()), nil
+	case value.TypeIDDatetime64:
+		return timeutil.UnmarshalDatetime64(v.GetInt64Value()), nil
+	case value.TypeIDTimestamp64:
+		return timeutil.UnmarshalTimestamp64(v.GetInt64Value()), nil
+	case Ydb.Type_TZ_DATE:
+		return timeutil.UnmarshalTzDate(v.GetTextValue())
+	case Ydb.Type_TZ_DATETIME:
+		return timeutil.UnmarshalTzDatetime(v.GetTextValue())
+	case Ydb.Type_TZ_TIMESTAMP:
+		return timeutil.UnmarshalTzTimestamp(v.GetTextValue())
+	case Ydb.Type_STRING:
+		return v.GetBytesValue(), nil
+	case Ydb.Type_UTF8, Ydb.Type_YSON, Ydb.Type_JSON, Ydb.Type_JSON_DOCUMENT, Ydb.Type_DYNUMBER:
+		return v.GetTextValue(), nil
+	case Ydb.Type_UUID:
+		return value.BigEndianUint128(v.GetHigh_128(), v.GetLow_128()), nil
+	default:
+		return nil, xerrors.WithStackTrace(fmt.Errorf("unsupported primitive type: %v", id))
+	}
+}
+
+//nolint:gocyclo,funlen
+func toGo(t *Ydb.Type, v *Ydb.Value) (_ interface{}, err error) {
+	if _, ok := value.PgTypeFromYDB(t); ok {
+		return v.GetTextValue(), nil
+	}
+	switch tt := t.Type.(type) {
+	case *Ydb.Type_TypeId:
+		return primitiveToGo(tt.TypeId, v)
+
+	case *Ydb.Type_DecimalType:
+		return Decimal{
+			Bytes:     value.BigEndianUint128(v.GetHigh_128(), v.GetLow_128()),
+			Precision: tt.DecimalType.Precision,
+			Scale:     tt.DecimalType.Scale,
+		}, nil
+
+	case *Ydb.Type_OptionalType:
+		if _, null := v.Value.(*Ydb.Value_NullFlagValue); null {
+			return nil, nil
+		}
+		if _, optional := value.UntagYDB(tt.OptionalType.Item).Type.(*Ydb.Type_OptionalType); optional {
+			if nested, ok := v.Value.(*Ydb.Value_NestedValue); ok {
+				v = nested.NestedValue
+			}
+		}
+		x, err := toGo(tt.OptionalType.Item, v)
+		if err != nil {
+			return nil, err
+		}
+		if x == nil {
+			return new(interface{}), nil
+		}
+		p := reflect.New(reflect.TypeOf(x))
+		p.Elem().Set(reflect.ValueOf(x))
+		return p.Interface(), nil
+
+	case *Ydb.Type_ListType:
+		items := make([]interface{}, len(v.Items))
+		for i, item := range v.Items {
+			if items[i], err = toGo(tt.ListType.Item, item); err != nil {
+				return nil, err
+			}
+		}
+		return items, nil
+
+	case *Ydb.Type_TupleType:
+		if len(v.Items) != len(tt.TupleType.Elements) {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("tuple items count %d not matches to type", len(v.Items)))
+		}
+		items := make([]interface{}, len(v.Items))
+		for i, item := range v.Items {
+			if items[i], err = toGo(tt.TupleType.Elements[i], item); err != nil {
+				return nil, err
+			}
+		}
+		return items, nil
+
+	case *Ydb.Type_StructType:
+		if len(v.Items) != len(tt.StructType.Members) {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("struct items count %d not matches to type", len(v.Items)))
+		}
+		members := make(map[string]interface{}, len(v.Items))
+		for i, item := range v.Items {
+			if members[tt.StructType.Members[i].Name], err = toGo(tt.StructType.Members[i].Type, item); err != nil {
+				return nil, err
+			}
+		}
+		return members, nil
+
+	case *Ydb.Type_DictType:
+		dict := make(map[interface{}]interface{}, len(v.Pairs))
+		for _, pair := range v.Pairs {
+			k, err := toGo(tt.DictType.Key, pair.Key)
+			if err != nil {
+				return nil, err
+			}
+			if b, ok := k.([]byte); ok {
+				k = string(b)
+			}
+			if k != nil && !reflect.TypeOf(k).Comparable() {
+				return nil, xerrors.WithStackTrace(fmt.Errorf("dict key of type %T is not comparable", k))
+			}
+			if dict[k], err = toGo(tt.DictType.Payload, pair.Payload); err != nil {
+				return nil, err
+			}
+		}
+		return dict, nil
+
+	case *Ydb.Type_VariantType:
+		nested, ok := v.Value.(*Ydb.Value_NestedValue)
+		if !ok {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("variant value without nested value"))
+		}
+		var (
+			i    = int(v.VariantIndex)
+			name string
+			item *Ydb.Type
+		)
+		switch items := tt.VariantType.Type.(type) {
+		case *Ydb.VariantType_TupleItems:
+			if i < len(items.TupleItems.Elements) {
+				name, item = strconv.Itoa(i), items.TupleItems.Elements[i]
+			}
+		case *Ydb.VariantType_StructItems:
+			if i < len(items.StructItems.Members) {
+				name, item = items.StructItems.Members[i].Name, items.StructItems.Members[i].Type
+			}
+		}
+		if item == nil {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("variant index %d out of type bounds", i))
+		}
+		x, err := toGo(item, nested.NestedValue)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{name: x}, nil
+
+	case *Ydb.Type_TaggedType:
+		return toGo(tt.TaggedType.Type, v)
+
+	case *Ydb.Type_VoidType, *Ydb.Type_NullType:
+		return nil, nil
+
+	case *Ydb.Type_EmptyListType:
+		return []interface{}{}, nil
+
+	case *Ydb.Type_EmptyDictType:
+		return map[interface{}]interface{}{}, nil
+
+	default:
+		return nil, xerrors.WithStackTrace(fmt.Errorf("unsupported type: %T", tt))
+	}
+}
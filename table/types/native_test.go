@@ -0,0 +1,159 @@
+package types
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestToGo(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		v    Value
+		exp  interface{}
+	}{
+		{
+			name: "int32",
+			v:    Int32Value(42),
+			exp:  int32(42),
+		},
+		{
+			name: "string",
+			v:    StringValue([]byte("test")),
+			exp:  []byte("test"),
+		},
+		{
+			name: "utf8",
+			v:    UTF8Value("test"),
+			exp:  "test",
+		},
+		{
+			name: "date",
+			v:    DateValue(1),
+			exp:  time.Unix(24*60*60, 0),
+		},
+		{
+			name: "interval",
+			v:    IntervalValueFromDuration(time.Second),
+			exp:  time.Second,
+		},
+		{
+			name: "date32",
+			v:    Date32ValueFromTime(time.Unix(-24*60*60, 0)),
+			exp:  time.Unix(-24*60*60, 0),
+		},
+		{
+			name: "timestamp64",
+			v:    Timestamp64ValueFromTime(time.Unix(-1, 500000000)),
+			exp:  time.Unix(-1, 500000000),
+		},
+		{
+			name: "tagged",
+			v:    TaggedValue("id", Int32Value(42)),
+			exp:  int32(42),
+		},
+		{
+			name: "pg",
+			v:    PgValue(PgTextOID, "test"),
+			exp:  "test",
+		},
+		{
+			name: "uuid",
+			v:    UUIDValue([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}),
+			exp:  [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+		},
+		{
+			name: "decimal",
+			v:    DecimalValue(&Decimal{Bytes: [16]byte{15: 1}, Precision: 22, Scale: 9}),
+			exp:  Decimal{Bytes: [16]byte{15: 1}, Precision: 22, Scale: 9},
+		},
+		{
+			name: "optional",
+			v:    OptionalValue(Int32Value(42)),
+			exp:  func(v int32) *int32 { return &v }(42),
+		},
+		{
+			name: "null",
+			v:    NullValue(TypeInt32),
+			exp:  nil,
+		},
+		{
+			name: "optional of optional",
+			v:    OptionalValue(OptionalValue(Int32Value(42))),
+			exp: func(v int32) **int32 {
+				p := &v
+				return &p
+			}(42),
+		},
+		{
+			name: "list",
+			v:    ListValue(Int32Value(1), Int32Value(2)),
+			exp:  []interface{}{int32(1), int32(2)},
+		},
+		{
+			name: "empty list",
+			v:    ZeroValue(List(TypeInt32)),
+			exp:  []interface{}{},
+		},
+		{
+			name: "tuple",
+			v:    TupleValue(Int32Value(1), UTF8Value("2")),
+			exp:  []interface{}{int32(1), "2"},
+		},
+		{
+			name: "struct",
+			v: StructValue(
+				StructFieldValue("id", Uint64Value(1)),
+				StructFieldValue("tags", ListValue(UTF8Value("a"))),
+				StructFieldValue("title", NullValue(TypeUTF8)),
+			),
+			exp: map[string]interface{}{
+				"id":    uint64(1),
+				"tags":  []interface{}{"a"},
+				"title": nil,
+			},
+		},
+		{
+			name: "optional struct",
+			v:    OptionalValue(StructValue(StructFieldValue("id", Uint64Value(1)))),
+			exp: func(v map[string]interface{}) *map[string]interface{} {
+				return &v
+			}(map[string]interface{}{"id": uint64(1)}),
+		},
+		{
+			name: "dict",
+			v: DictValue(
+				DictFieldValue(StringValue([]byte("a")), Int32Value(1)),
+				DictFieldValue(StringValue([]byte("b")), Int32Value(2)),
+			),
+			exp: map[interface{}]interface{}{"a": int32(1), "b": int32(2)},
+		},
+		{
+			name: "variant",
+			v:    VariantValue(Int32Value(42), 1, Variant(Struct(StructField("foo", TypeString), StructField("bar", TypeInt32)))),
+			exp:  map[string]interface{}{"bar": int32(42)},
+		},
+		{
+			name: "void",
+			v:    VoidValue(),
+			exp:  nil,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			v, err := ToGo(test.v)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(v, test.exp) {
+				t.Errorf("unexpected value: %#v, want: %#v", v, test.exp)
+			}
+		})
+	}
+}
+
+func TestToGoNotComparableDictKey(t *testing.T) {
+	_, err := ToGo(DictValue(DictFieldValue(ListValue(Int32Value(1)), Int32Value(1))))
+	if err == nil {
+		t.Errorf("unexpected success")
+	}
+}
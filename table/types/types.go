@@ -69,6 +69,11 @@ func Optional(t Type) Type {
 	return value.Optional(t)
 }
 
+// Tagged returns type t marked with tag such as Tagged<Int32,'id'>
+func Tagged(tag string, t Type) Type {
+	return value.Tagged(tag, t)
+}
+
 var DefaultDecimal = DecimalType(22, 9)
 
 func DecimalType(precision, scale uint32) Type {
@@ -109,6 +114,36 @@ const (
 	TypeUUID         = value.TypeUUID
 	TypeJSONDocument = value.TypeJSONDocument
 	TypeDyNumber     = value.TypeDyNumber
+	TypeDate32       = value.TypeDate32
+	TypeDatetime64   = value.TypeDatetime64
+	TypeTimestamp64  = value.TypeTimestamp64
+	TypeInterval64   = value.TypeInterval64
+)
+
+// PgType is a type of PostgreSQL-compatible values
+type PgType = value.PgType
+
+// Pg returns PostgreSQL-compatible type with given OID
+func Pg(oid uint32) Type {
+	return value.Pg(oid)
+}
+
+// OIDs of frequently used PostgreSQL-compatible types
+const (
+	PgBoolOID        = 16
+	PgInt8OID        = 20
+	PgInt2OID        = 21
+	PgInt4OID        = 23
+	PgTextOID        = 25
+	PgFloat4OID      = 700
+	PgFloat8OID      = 701
+	PgVarcharOID     = 1043
+	PgDateOID        = 1082
+	PgTimestampOID   = 1114
+	PgTimestamptzOID = 1184
+	PgNumericOID     = 1700
+	PgUUIDOID        = 2950
+	PgJSONBOID       = 3802
 )
 
 func WriteTypeStringTo(buf *bytes.Buffer, t Type) {
@@ -135,6 +170,10 @@ type RawValue interface {
 	Datetime() (v time.Time)
 	Timestamp() (v time.Time)
 	Interval() (v time.Duration)
+	Date32() (v time.Time)
+	Datetime64() (v time.Time)
+	Timestamp64() (v time.Time)
+	Interval64() (v time.Duration)
 	TzDate() (v time.Time)
 	TzDatetime() (v time.Time)
 	TzTimestamp() (v time.Time)
@@ -145,8 +184,13 @@ type RawValue interface {
 	UUID() (v [16]byte)
 	JSONDocument() (v []byte)
 	DyNumber() (v string)
+	Pg() (v string)
 	Value() Value
 
+	// Tag returns tag of current item under scan if type of item is Tagged<T,tag>
+	// or empty string otherwise. Tagged items are scanned as items of inner type T
+	Tag() (tag string)
+
 	// Any returns any primitive or optional value.
 	// Currently, it may return one of these types:
 	//
@@ -249,3 +293,9 @@ type Scanner interface {
 	// UnmarshalYDB must be implemented on client-side for unmarshal raw ydb value.
 	UnmarshalYDB(raw RawValue) error
 }
+
+// ParseType parses YQL type from text such as "Optional<List<Struct<a:Int32>>>".
+// ParseType is inverse of WriteTypeStringTo and also accepts shorthands such as "Int32?"
+func ParseType(s string) (Type, error) {
+	return value.ParseType(s)
+}
@@ -300,3 +300,26 @@ func TestWriteTypeStringTo(t *testing.T) {
 		})
 	}
 }
+
+func TestParseTypeAndValue(t *testing.T) {
+	v := OptionalValue(ListValue(StructValue(
+		StructFieldValue("a", Int32Value(1)),
+		StructFieldValue("b c", UTF8Value("(x)")),
+	)))
+	var buf bytes.Buffer
+	WriteTypeStringTo(&buf, v.Type())
+	tt, err := ParseType(buf.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !Equal(tt, v.Type()) {
+		t.Errorf("unexpected type: %s, want: %s", tt, v.Type())
+	}
+	vv, err := ParseValue(v.String(), tt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if vv.String() != v.String() {
+		t.Errorf("unexpected value: %s, want: %s", vv, v)
+	}
+}
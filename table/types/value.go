@@ -50,6 +50,18 @@ func IntervalValueFromMicroseconds(v int64) Value { return value.IntervalValue(v
 // Deprecated: use IntervalValueFromMicroseconds instead
 func IntervalValue(v int64) Value { return value.IntervalValue(v) }
 
+// Date32Value makes Date32 value from signed number of days since epoch
+func Date32Value(v int32) Value { return value.Date32Value(v) }
+
+// Datetime64Value makes Datetime64 value from signed number of seconds since epoch
+func Datetime64Value(v int64) Value { return value.Datetime64Value(v) }
+
+// Timestamp64Value makes Timestamp64 value from signed number of microseconds since epoch
+func Timestamp64Value(v int64) Value { return value.Timestamp64Value(v) }
+
+// Interval64Value makes Interval64 value from given microseconds value
+func Interval64Value(v int64) Value { return value.Interval64Value(v) }
+
 // TzDateValue makes TzDate value from string
 func TzDateValue(v string) Value { return value.TzDateValue(v) }
 
@@ -89,6 +101,24 @@ func IntervalValueFromDuration(v time.Duration) Value {
 	return value.IntervalValue(timeutil.DurationToMicroseconds(v))
 }
 
+// Date32ValueFromTime makes Date32 value from time.Time
+func Date32ValueFromTime(v time.Time) Value { return value.Date32Value(timeutil.MarshalDate32(v)) }
+
+// Datetime64ValueFromTime makes Datetime64 value from time.Time
+func Datetime64ValueFromTime(v time.Time) Value {
+	return value.Datetime64Value(timeutil.MarshalDatetime64(v))
+}
+
+// Timestamp64ValueFromTime makes Timestamp64 value from time.Time
+func Timestamp64ValueFromTime(v time.Time) Value {
+	return value.Timestamp64Value(timeutil.MarshalTimestamp64(v))
+}
+
+// Interval64ValueFromDuration makes Interval64 value from time.Duration
+func Interval64ValueFromDuration(v time.Duration) Value {
+	return value.Interval64Value(timeutil.DurationToMicroseconds(v))
+}
+
 // TzDateValueFromTime makes TzDate value from time.Time
 //
 // Warning: all *From* helpers will be removed at next major release
@@ -155,6 +185,11 @@ func JSONDocumentValueFromBytes(v []byte) Value { return value.JSONDocumentValue
 
 func DyNumberValue(v string) Value { return value.DyNumberValue(v) }
 
+// PgValue makes PostgreSQL-compatible value of type with given OID from text representation of value
+//
+//	v := types.PgValue(types.PgInt4OID, "42")
+func PgValue(oid uint32, text string) Value { return value.PgValue(oid, text) }
+
 func VoidValue() Value { return value.VoidValue() }
 
 func NullValue(t Type) Value { return value.NullValue(t) }
@@ -163,21 +198,8 @@ func ZeroValue(t Type) Value { return value.ZeroValue(t) }
 
 func OptionalValue(v Value) Value { return value.OptionalValue(v) }
 
-// Decimal supported in scanner API
-type Decimal struct {
-	Bytes     [16]byte
-	Precision uint32
-	Scale     uint32
-}
-
-func (d *Decimal) String() string {
-	v := decimal.FromInt128(d.Bytes, d.Precision, d.Scale)
-	return decimal.Format(v, d.Precision, d.Scale)
-}
-
-func (d *Decimal) BigInt() *big.Int {
-	return decimal.FromInt128(d.Bytes, d.Precision, d.Scale)
-}
+// TaggedValue makes value of type Tagged<T,tag> where T is a type of v
+func TaggedValue(tag string, v Value) Value { return value.TaggedValue(tag, v) }
 
 // DecimalValue creates decimal value of given types t and value v.
 // Note that Decimal.Bytes interpreted as big-endian int128.
@@ -690,3 +712,10 @@ func Nullable(t Type, v interface{}) Value {
 		panic(fmt.Sprintf("unsupported type: %T", t))
 	}
 }
+
+// ParseValue parses value of type t from text literal in format of Value.String()
+// such as "List<Int32>((1)(2)(3))". Type prefix of literal is optional and must be equal
+// to t if defined. If t is nil then type of value parses from type prefix of literal
+func ParseValue(text string, t Type) (Value, error) {
+	return value.ParseValue(text, t)
+}
@@ -18,8 +18,11 @@ var ErrNotComparable = xerrors.Wrap(fmt.Errorf("not comparable"))
 // Compare compares its operands.
 // It returns -1, 0, 1 if l < r, l == r, l > r. Returns error if types are not comparable.
 // Comparable types are all integer types, UUID, DyNumber, Float, Double, String, UTF8,
-// Date, Datetime, Timestamp, Tuples and Lists.
+// Date, Datetime, Timestamp, Date32, Datetime64, Timestamp64, Interval64, PostgreSQL-compatible values,
+// Tuples and Lists.
 // Primitive arguments are comparable if their types are the same.
+// PostgreSQL-compatible values are comparable if their OIDs are the same. Values of numeric PostgreSQL types
+// compares as numbers, other values compares by text representation.
 // Optional types is comparable to underlying types, e.g. Optional<Optional<Float>> is comparable to Float.
 // Null value is comparable to non-null value of the same types and is considered less than any non-null value.
 // Tuples and Lists are comparable if their elements are comparable.
@@ -52,6 +55,8 @@ func compare(l *Ydb.TypedValue, r *Ydb.TypedValue) (int, error) {
 		return 0, notComparableError(l, r)
 	case lTypeID != Ydb.Type_PRIMITIVE_TYPE_ID_UNSPECIFIED:
 		return comparePrimitives(lTypeID, l.Value, r.Value)
+	case isPg(l.Type) || isPg(r.Type):
+		return comparePg(l, r)
 	case l.Type.GetTupleType() != nil && r.Type.GetTupleType() != nil:
 		return compareTuplesOrLists(expandTuple(l), expandTuple(r))
 	case l.Type.GetListType() != nil && r.Type.GetListType() != nil:
@@ -153,8 +158,52 @@ var comparators = map[Ydb.Type_PrimitiveTypeId]comparator{
 	Ydb.Type_STRING:    compareBytes,
 	Ydb.Type_UTF8:      compareText,
 	Ydb.Type_UUID:      compareUUID,
+
+	value.TypeIDDate32:      compareInt32,
+	value.TypeIDDatetime64:  compareInt64,
+	value.TypeIDTimestamp64: compareInt64,
+	value.TypeIDInterval64:  compareInt64,
+}
+
+func isPg(t *Ydb.Type) bool {
+	_, ok := value.PgTypeFromYDB(t)
+	return ok
 }
 
+func comparePg(l, r *Ydb.TypedValue) (int, error) {
+	lt, lok := value.PgTypeFromYDB(l.Type)
+	rt, rok := value.PgTypeFromYDB(r.Type)
+	if !lok || !rok || lt.OID != rt.OID {
+		return 0, notComparableError(l, r)
+	}
+	_, lIsNull := l.Value.Value.(*Ydb.Value_NullFlagValue)
+	_, rIsNull := r.Value.Value.(*Ydb.Value_NullFlagValue)
+	switch {
+	case lIsNull && rIsNull:
+		return 0, nil
+	case lIsNull:
+		return -1, nil
+	case rIsNull:
+		return 1, nil
+	}
+	switch lt.OID {
+	case pgInt2OID, pgInt4OID, pgInt8OID, pgFloat4OID, pgFloat8OID, pgNumericOID:
+		return compareDyNumber(l.Value, r.Value)
+	default:
+		return compareText(l.Value, r.Value), nil
+	}
+}
+
+// OIDs of numeric PostgreSQL-compatible types
+const (
+	pgInt8OID    = 20
+	pgInt2OID    = 21
+	pgInt4OID    = 23
+	pgFloat4OID  = 700
+	pgFloat8OID  = 701
+	pgNumericOID = 1700
+)
+
 func compareUint32(l, r *Ydb.Value) int {
 	ll := l.GetUint32Value()
 	rr := r.GetUint32Value()
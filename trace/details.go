@@ -49,6 +49,8 @@ const (
 	// Deprecated: has no effect now
 	DriverClusterEvents
 
+	RetryDeniedEvents
+
 	DriverEvents = DriverNetEvents |
 		DriverConnEvents |
 		DriverBalancerEvents |
@@ -100,7 +102,8 @@ var (
 
 		DiscoveryEvents: "ydb.discovery",
 
-		RetryEvents: "ydb.retry",
+		RetryEvents:       "ydb.retry",
+		RetryDeniedEvents: "ydb.retry.denied",
 
 		SchemeEvents: "ydb.scheme",
 
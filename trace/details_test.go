@@ -53,13 +53,17 @@ func TestDetailsMatch(t *testing.T) {
 			pattern: `^ydb\.retry$`,
 			details: RetryEvents,
 		},
+		{
+			pattern: `^ydb\.retry\.denied$`,
+			details: RetryDeniedEvents,
+		},
 		{
 			pattern: `^ydb\.discovery$`,
 			details: DiscoveryEvents,
 		},
 		{
 			pattern: `^ydb\.(driver|discovery|retry|table|scheme).*$`,
-			details: DriverEvents | DiscoveryEvents | RetryEvents | TableEvents | SchemeEvents,
+			details: DriverEvents | DiscoveryEvents | RetryEvents | RetryDeniedEvents | TableEvents | SchemeEvents,
 		},
 		{
 			pattern: `^ydb\.table\.(pool\.(session|api)|session).*$`,
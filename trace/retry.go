@@ -13,6 +13,9 @@ type (
 	// gtrace:gen
 	Retry struct {
 		OnRetry func(RetryLoopStartInfo) func(RetryLoopIntermediateInfo) func(RetryLoopDoneInfo)
+		// OnRetryDenied called when retry of failed call denied with limits of retry policy,
+		// retry budget or circuit breaker
+		OnRetryDenied func(RetryDeniedInfo)
 	}
 	RetryLoopStartInfo struct {
 		// Context make available context in trace callback function.
@@ -30,4 +33,18 @@ type (
 		Attempts int
 		Error    error
 	}
+	RetryDeniedInfo struct {
+		// Context make available context in trace callback function.
+		// Pointer to context provide replacement of context in trace callback function.
+		// Warning: concurrent access to pointer on client side must be excluded.
+		// Safe replacement of context are provided only inside callback function
+		Context  *context.Context
+		ID       string
+		Attempts int
+		// Error is an error of last attempt
+		Error error
+		// Reason is a reason of denied retry (retry.ErrMaxAttempts, retry.ErrMaxElapsed,
+		// retry.ErrBudgetExhausted or retry.ErrCircuitOpen)
+		Reason error
+	}
 )
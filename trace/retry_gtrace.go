@@ -78,6 +78,25 @@ func (t Retry) Compose(x Retry, opts ...RetryComposeOption) (ret Retry) {
 			}
 		}
 	}
+	{
+		h1 := t.OnRetryDenied
+		h2 := x.OnRetryDenied
+		ret.OnRetryDenied = func(r RetryDeniedInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			if h1 != nil {
+				h1(r)
+			}
+			if h2 != nil {
+				h2(r)
+			}
+		}
+	}
 	return ret
 }
 func (t Retry) onRetry(r RetryLoopStartInfo) func(RetryLoopIntermediateInfo) func(RetryLoopDoneInfo) {
@@ -107,6 +126,13 @@ func (t Retry) onRetry(r RetryLoopStartInfo) func(RetryLoopIntermediateInfo) fun
 		return res
 	}
 }
+func (t Retry) onRetryDenied(r RetryDeniedInfo) {
+	fn := t.OnRetryDenied
+	if fn == nil {
+		return
+	}
+	fn(r)
+}
 func RetryOnRetry(t Retry, c *context.Context, iD string, idempotent bool) func(error) func(attempts int, _ error) {
 	var p RetryLoopStartInfo
 	p.Context = c
@@ -125,3 +151,12 @@ func RetryOnRetry(t Retry, c *context.Context, iD string, idempotent bool) func(
 		}
 	}
 }
+func RetryOnRetryDenied(t Retry, c *context.Context, iD string, attempts int, e error, reason error) {
+	var p RetryDeniedInfo
+	p.Context = c
+	p.ID = iD
+	p.Attempts = attempts
+	p.Error = e
+	p.Reason = reason
+	t.onRetryDenied(p)
+}
@@ -80,6 +80,9 @@ type (
 		//
 		// Deprecated: use OnPoolSessionRemove callback
 		OnPoolSessionClose func(TablePoolSessionCloseStartInfo) func(TablePoolSessionCloseDoneInfo)
+		// Pool node-aware placement events
+		OnPoolNodesUpdate func(TablePoolNodesUpdateInfo)
+		OnPoolRebalance   func(TablePoolRebalanceStartInfo) func(TablePoolRebalanceDoneInfo)
 		// Pool common API events
 		OnPoolPut  func(TablePoolPutStartInfo) func(TablePoolPutDoneInfo)
 		OnPoolGet  func(TablePoolGetStartInfo) func(TablePoolGetDoneInfo)
@@ -297,6 +300,25 @@ type (
 		Size  int
 		Event string
 	}
+	TablePoolNodesUpdateInfo struct {
+		Nodes   []uint32
+		Added   []uint32
+		Removed []uint32
+	}
+	TablePoolRebalanceStartInfo struct {
+		// Context make available context in trace callback function.
+		// Pointer to context provide replacement of context in trace callback function.
+		// Warning: concurrent access to pointer on client side must be excluded.
+		// Safe replacement of context are provided only inside callback function
+		Context    *context.Context
+		Session    tableSessionInfo
+		FromNodeID uint32
+		ToNodeID   uint32
+	}
+	TablePoolRebalanceDoneInfo struct {
+		Session tableSessionInfo
+		Error   error
+	}
 	TablePoolSessionNewStartInfo struct {
 		// Context make available context in trace callback function.
 		// Pointer to context provide replacement of context in trace callback function.
@@ -864,6 +864,60 @@ func (t Table) Compose(x Table, opts ...TableComposeOption) (ret Table) {
 			}
 		}
 	}
+	{
+		h1 := t.OnPoolNodesUpdate
+		h2 := x.OnPoolNodesUpdate
+		ret.OnPoolNodesUpdate = func(t TablePoolNodesUpdateInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			if h1 != nil {
+				h1(t)
+			}
+			if h2 != nil {
+				h2(t)
+			}
+		}
+	}
+	{
+		h1 := t.OnPoolRebalance
+		h2 := x.OnPoolRebalance
+		ret.OnPoolRebalance = func(t TablePoolRebalanceStartInfo) func(TablePoolRebalanceDoneInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			var r, r1 func(TablePoolRebalanceDoneInfo)
+			if h1 != nil {
+				r = h1(t)
+			}
+			if h2 != nil {
+				r1 = h2(t)
+			}
+			return func(t TablePoolRebalanceDoneInfo) {
+				if options.panicCallback != nil {
+					defer func() {
+						if e := recover(); e != nil {
+							options.panicCallback(e)
+						}
+					}()
+				}
+				if r != nil {
+					r(t)
+				}
+				if r1 != nil {
+					r1(t)
+				}
+			}
+		}
+	}
 	{
 		h1 := t.OnPoolPut
 		h2 := x.OnPoolPut
@@ -1352,6 +1406,28 @@ func (t Table) onPoolSessionClose(t1 TablePoolSessionCloseStartInfo) func(TableP
 	}
 	return res
 }
+func (t Table) onPoolNodesUpdate(t1 TablePoolNodesUpdateInfo) {
+	fn := t.OnPoolNodesUpdate
+	if fn == nil {
+		return
+	}
+	fn(t1)
+}
+func (t Table) onPoolRebalance(t1 TablePoolRebalanceStartInfo) func(TablePoolRebalanceDoneInfo) {
+	fn := t.OnPoolRebalance
+	if fn == nil {
+		return func(TablePoolRebalanceDoneInfo) {
+			return
+		}
+	}
+	res := fn(t1)
+	if res == nil {
+		return func(TablePoolRebalanceDoneInfo) {
+			return
+		}
+	}
+	return res
+}
 func (t Table) onPoolPut(t1 TablePoolPutStartInfo) func(TablePoolPutDoneInfo) {
 	fn := t.OnPoolPut
 	if fn == nil {
@@ -1682,6 +1758,27 @@ func TableOnPoolSessionClose(t Table, c *context.Context, session tableSessionIn
 		res(p)
 	}
 }
+func TableOnPoolNodesUpdate(t Table, nodes []uint32, added []uint32, removed []uint32) {
+	var p TablePoolNodesUpdateInfo
+	p.Nodes = nodes
+	p.Added = added
+	p.Removed = removed
+	t.onPoolNodesUpdate(p)
+}
+func TableOnPoolRebalance(t Table, c *context.Context, session tableSessionInfo, fromNodeID uint32, toNodeID uint32) func(session tableSessionInfo, _ error) {
+	var p TablePoolRebalanceStartInfo
+	p.Context = c
+	p.Session = session
+	p.FromNodeID = fromNodeID
+	p.ToNodeID = toNodeID
+	res := t.onPoolRebalance(p)
+	return func(session tableSessionInfo, e error) {
+		var p TablePoolRebalanceDoneInfo
+		p.Session = session
+		p.Error = e
+		res(p)
+	}
+}
 func TableOnPoolPut(t Table, c *context.Context, session tableSessionInfo) func(error) {
 	var p TablePoolPutStartInfo
 	p.Context = c